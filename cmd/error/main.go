@@ -56,4 +56,18 @@ func main() {
 	// Case error to ErrorWrapper
 	errCast := doError().(*ero.ErrorWrapper)
 	logger.Error("Cast error to ErrorWrapper", zap.Error(errCast.Detail()))
+
+	// Map error to HTTP status and gRPC status
+	mapper := ero.NewStatusMapper("go-utils").
+		Register("ACCOUNT_LOCKED", ero.Mapping{HTTPStatus: 423, GRPCCode: ero.GRPCFailedPrecondition, Message: "Your account has been locked"})
+	errNotFound := ero.New("Account id=123 not found").WithCode(ero.CodeNotFound)
+	errLocked := ero.New("Account id=123 locked by admin").WithCode("ACCOUNT_LOCKED")
+	logger.Info("Problem", zap.Any("Not found", mapper.ToProblem(errNotFound)), zap.Any("Locked", mapper.ToProblem(errLocked)))
+	logger.Info("gRPC status", zap.Any("Not found", mapper.ToGRPCStatus(errNotFound)), zap.Any("Locked", mapper.ToGRPCStatus(errLocked)))
+
+	// Convert panic in goroutine to error
+	errPanic := <-ero.Go(func() error {
+		panic("Worker crashed")
+	})
+	logger.Error("Panic in goroutine", zap.String("Error", errPanic.Error()))
 }
@@ -0,0 +1,35 @@
+// Package i18n provides the locale tags shared by the error catalog and the humanized datetime,
+// so they normalize and fall back the locales in the same way.
+package i18n
+
+import "strings"
+
+const (
+	// EN is the English locale.
+	EN = "en"
+	// VI is the Vietnamese locale.
+	VI = "vi"
+)
+
+// Normalize returns the locale in lower case with "-" as separator, e.g. " vi_VN " is "vi-vn".
+func Normalize(locale string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(locale), "_", "-", -1))
+}
+
+// Lookup returns the value of locale in values, whose keys are normalized. If locale has a region such as "vi-VN"
+// and it is not in values, the value of its language "vi" is returned.
+func Lookup[T any](values map[string]T, locale string) (T, bool) {
+	locale = Normalize(locale)
+	if value, ok := values[locale]; ok {
+		return value, true
+	}
+
+	if i := strings.IndexByte(locale, '-'); i > 0 {
+		if value, ok := values[locale[:i]]; ok {
+			return value, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,56 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		locale   string
+		expected string
+	}{
+		{locale: "vi", expected: "vi"},
+		{locale: " vi_VN ", expected: "vi-vn"},
+		{locale: "pt-BR", expected: "pt-br"},
+		{locale: "", expected: ""},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := Normalize(table.locale)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func TestLookup_MultipleCase(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	values := map[string]string{EN: "hello", VI: "xin chào", "pt-br": "olá"}
+	tables := []struct {
+		locale        string
+		expected      string
+		expectedFound bool
+	}{
+		{locale: "EN", expected: "hello", expectedFound: true},
+		{locale: "vi_VN", expected: "xin chào", expectedFound: true},
+		{locale: "pt-BR", expected: "olá", expectedFound: true},
+		{locale: "pt-PT", expected: "", expectedFound: false},
+		{locale: "fr", expected: "", expectedFound: false},
+		{locale: "", expected: "", expectedFound: false},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, found := Lookup(values, table.locale)
+
+		// THEN
+		assert.Equal(table.expected, actual, table.locale)
+		assert.Equal(table.expectedFound, found, table.locale)
+	}
+}
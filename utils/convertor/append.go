@@ -0,0 +1,145 @@
+package conv
+
+import (
+	"reflect"
+	"strconv"
+	"unsafe"
+)
+
+// Append appends T formatted as Format to dst and returns the extended buffer.
+// It does not allocate if dst has enough capacity, so a buffer can be reused in the hot paths such as log and CSV writers.
+//
+// 	buf := make([]byte, 0, 64)
+// 	buf = conv.Append(buf, 123456)
+// 	buf = append(buf, ',')
+// 	buf = conv.Append(buf, true)
+func Append[T Value](dst []byte, value T) []byte {
+	switch v := any(value).(type) {
+	case int:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int8:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int16:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int32:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int64:
+		return strconv.AppendInt(dst, v, 10)
+	case uint:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint8:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint16:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint32:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint64:
+		return strconv.AppendUint(dst, v, 10)
+	case float32:
+		return appendFloat(dst, float64(v), 32, DefaultFloatFormat())
+	case float64:
+		return appendFloat(dst, v, 64, DefaultFloatFormat())
+	case bool:
+		return strconv.AppendBool(dst, v)
+	}
+
+	return appendValue(dst, reflect.ValueOf(&value).Elem())
+}
+
+// AppendInt appends int to dst. See Append.
+func AppendInt(dst []byte, num int) []byte {
+	return Append(dst, num)
+}
+
+// AppendInt8 appends int8 to dst. See Append.
+func AppendInt8(dst []byte, num int8) []byte {
+	return Append(dst, num)
+}
+
+// AppendInt16 appends int16 to dst. See Append.
+func AppendInt16(dst []byte, num int16) []byte {
+	return Append(dst, num)
+}
+
+// AppendInt32 appends int32 to dst. See Append.
+func AppendInt32(dst []byte, num int32) []byte {
+	return Append(dst, num)
+}
+
+// AppendInt64 appends int64 to dst. See Append.
+func AppendInt64(dst []byte, num int64) []byte {
+	return Append(dst, num)
+}
+
+// AppendUInt appends uint to dst. See Append.
+func AppendUInt(dst []byte, num uint) []byte {
+	return Append(dst, num)
+}
+
+// AppendUInt8 appends uint8 to dst. See Append.
+func AppendUInt8(dst []byte, num uint8) []byte {
+	return Append(dst, num)
+}
+
+// AppendUInt16 appends uint16 to dst. See Append.
+func AppendUInt16(dst []byte, num uint16) []byte {
+	return Append(dst, num)
+}
+
+// AppendUInt32 appends uint32 to dst. See Append.
+func AppendUInt32(dst []byte, num uint32) []byte {
+	return Append(dst, num)
+}
+
+// AppendUInt64 appends uint64 to dst. See Append.
+func AppendUInt64(dst []byte, num uint64) []byte {
+	return Append(dst, num)
+}
+
+// AppendBool appends "true" or "false" to dst. See Append.
+func AppendBool(dst []byte, b bool) []byte {
+	return Append(dst, b)
+}
+
+// AppendFloat32 appends float32 in DefaultFloatFormat to dst. See Append.
+func AppendFloat32(dst []byte, num float32) []byte {
+	return Append(dst, num)
+}
+
+// AppendFloat64 appends float64 in DefaultFloatFormat to dst. See Append.
+func AppendFloat64(dst []byte, num float64) []byte {
+	return Append(dst, num)
+}
+
+// ParseByteSlice converts the byte slice to T as Parse without converting it to string,
+// so the numbers can be read from the network buffers or the CSV fields without allocation.
+//
+// b is read through a string which shares its memory, so b must not be modified while ParseByteSlice runs.
+// Nothing returned aliases b: T is limited to the integer, float and bool kinds by Value, never a string kind,
+// and the returned error holds a copy of b. The caller may reuse or modify b after it returns.
+func ParseByteSlice[T Value](b []byte) (T, error) {
+	// The string shares the memory of b and must not be retained. Parse does not retain it except in the errors,
+	// in which it is replaced by a copy.
+	value, err := Parse[T](*(*string)(unsafe.Pointer(&b)))
+	if err != nil {
+		err = withNum(err, string(b))
+	}
+
+	return value, err
+}
+
+// appendValue appends the value of the kinds of Value, the named types such as "type Port uint16", to dst.
+func appendValue(dst []byte, rv reflect.Value) []byte {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(dst, rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(dst, rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return appendFloat(dst, rv.Float(), rv.Type().Bits(), DefaultFloatFormat())
+	case reflect.Bool:
+		return strconv.AppendBool(dst, rv.Bool())
+	}
+
+	return dst
+}
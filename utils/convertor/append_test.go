@@ -0,0 +1,189 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppend_MultipleType_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	buf := make([]byte, 0, 256)
+
+	// WHEN
+	buf = AppendInt(buf, -123456)
+	buf = append(buf, ',')
+	buf = AppendInt8(buf, -128)
+	buf = append(buf, ',')
+	buf = AppendInt16(buf, 32767)
+	buf = append(buf, ',')
+	buf = AppendInt32(buf, -2147483648)
+	buf = append(buf, ',')
+	buf = AppendInt64(buf, 9223372036854775807)
+	buf = append(buf, ',')
+	buf = AppendUInt(buf, 123456)
+	buf = append(buf, ',')
+	buf = AppendUInt8(buf, 255)
+	buf = append(buf, ',')
+	buf = AppendUInt16(buf, 65535)
+	buf = append(buf, ',')
+	buf = AppendUInt32(buf, 4294967295)
+	buf = append(buf, ',')
+	buf = AppendUInt64(buf, 18446744073709551615)
+	buf = append(buf, ',')
+	buf = AppendBool(buf, true)
+	buf = append(buf, ',')
+	buf = AppendFloat32(buf, 1.5)
+	buf = append(buf, ',')
+	buf = AppendFloat64(buf, 123.45)
+	buf = append(buf, ',')
+	buf = Append(buf, port(8080))
+	buf = append(buf, ',')
+	buf = Append(buf, ratio(0.25))
+
+	// THEN
+	assert.Equal("-123456,-128,32767,-2147483648,9223372036854775807,123456,255,65535,4294967295,18446744073709551615,true,1.5E+00,1.2345E+02,8080,2.5E-01", string(buf))
+}
+
+func TestAppendFloatAndInteger_WithFormat_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	buf := []byte("total=")
+
+	// WHEN
+	buf = AppendFloat(buf, 1234567.891, FloatFormat{Verb: 'f', Precision: 2, ThousandsSeparator: ","})
+	buf = append(buf, " id="...)
+	buf = AppendInteger(buf, uint32(31), IntegerFormat{Base: 16, Prefix: true, Width: 4, Upper: true})
+	buf = append(buf, " vnd="...)
+	buf = AppendFloat(buf, float32(-1234.5), FloatFormat{Verb: 'f', Precision: 2, TrimZeros: true, ThousandsSeparator: ".", DecimalSeparator: ","})
+
+	// THEN
+	assert.Equal("total=1,234,567.89 id=0x001F vnd=-1.234,5", string(buf))
+}
+
+func TestParseByteSlice_MultipleType_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	input := []byte("123456,abc")
+
+	// WHEN
+	i64, errInt64 := ParseByteSlice[int64](input[:6])
+	p, errPort := ParseByteSlice[port]([]byte("8080"))
+	b, errBool := ParseByteSlice[bool]([]byte("true"))
+	f, errFloat := ParseByteSlice[float64]([]byte("1.5"))
+	_, errSyntax := ParseByteSlice[int](input[7:])
+	copy(input[7:], "xyz")
+
+	// THEN
+	assert.Nil(errInt64)
+	assert.Nil(errPort)
+	assert.Nil(errBool)
+	assert.Nil(errFloat)
+	assert.Equal(int64(123456), i64)
+	assert.Equal(port(8080), p)
+	assert.True(b)
+	assert.Equal(1.5, f)
+	assert.Equal("strconv.ParseInt: parsing \"abc\": invalid syntax", errSyntax.Error())
+}
+
+func TestParseByteSlice_ReuseBuffer_NotAliased(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	buf := make([]byte, 0, 16)
+	fields := []string{"8080", "x1", "443", "y2"}
+	var ports []port
+	var errs []error
+
+	// WHEN
+	for _, field := range fields {
+		buf = append(buf[:0], field...)
+		p, err := ParseByteSlice[port](buf)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ports = append(ports, p)
+	}
+	copy(buf, "zz")
+
+	// THEN
+	assert.Equal([]port{8080, 443}, ports)
+	assert.Len(errs, 2)
+	assert.Equal("strconv.ParseUint: parsing \"x1\": invalid syntax", errs[0].Error())
+	assert.Equal("strconv.ParseUint: parsing \"y2\": invalid syntax", errs[1].Error())
+}
+
+func TestAppendAndParseByteSlice_ZeroAllocation(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	buf := make([]byte, 0, 64)
+	format := FloatFormat{Verb: 'f', Precision: 2, ThousandsSeparator: ","}
+	input := []byte("123456")
+
+	// WHEN
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendInt64(buf[:0], 9223372036854775807)
+		buf = AppendFloat64(buf, 123.45)
+		buf = AppendFloat(buf, 1234567.891, format)
+		buf = AppendInteger(buf, 31, IntegerFormat{Base: 16, Width: 4})
+		buf = Append(buf, port(8080))
+		_, _ = ParseByteSlice[int](input)
+		_, _ = ParseByteSlice[port](input[:4])
+	})
+
+	// THEN
+	assert.Equal(0.0, allocs)
+}
+
+func BenchmarkAppendInt(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buf = AppendInt(buf[:0], 123456)
+	}
+}
+
+func BenchmarkAppendFloat64(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buf = AppendFloat64(buf[:0], 123.45)
+	}
+}
+
+func BenchmarkAppendFloatWithFormat(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	format := FloatFormat{Verb: 'f', Precision: 2, TrimZeros: true, ThousandsSeparator: ","}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buf = AppendFloat(buf[:0], 1234567.891, format)
+	}
+}
+
+func BenchmarkAppendInteger(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	format := IntegerFormat{Base: 16, Prefix: true, Width: 8, Upper: true}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buf = AppendInteger(buf[:0], uint64(i), format)
+	}
+}
+
+func BenchmarkParseByteSlice(b *testing.B) {
+	input := []byte("123456")
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseByteSlice[int](input)
+	}
+}
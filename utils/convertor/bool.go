@@ -0,0 +1,89 @@
+package conv
+
+import (
+	"strings"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+var (
+	// DefaultTrueValues are the true values accepted by ParseBoolLenient.
+	DefaultTrueValues = []string{"1", "t", "true", "y", "yes", "on", "enable", "enabled"}
+	// DefaultFalseValues are the false values accepted by ParseBoolLenient.
+	DefaultFalseValues = []string{"0", "f", "false", "n", "no", "off", "disable", "disabled"}
+)
+
+// BoolOptions allows users to configure ParseBoolWithOptions.
+type BoolOptions struct {
+	// TrueValues are the values of true. If it is empty, DefaultTrueValues is used.
+	TrueValues []string
+	// FalseValues are the values of false. If it is empty, DefaultFalseValues is used.
+	FalseValues []string
+	// CaseSensitive matches the values exactly, otherwise "YES" and "Yes" are the same as "yes".
+	CaseSensitive bool
+}
+
+// LenientBool is a bool which can be read from "yes", "on", "Y" or "enabled" as a field of YAML or JSON config.
+//
+// 	type Config struct {
+// 		Debug conv.LenientBool `yaml:"debug" json:"debug"` // debug: on
+// 	}
+type LenientBool bool
+
+// UnmarshalText implements encoding.TextUnmarshaler with ParseBoolLenient.
+func (b *LenientBool) UnmarshalText(text []byte) error {
+	value, err := ParseBoolLenient(string(text))
+	if err != nil {
+		return err
+	}
+
+	*b = LenientBool(value)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, so the bool can be read from both "yes" and true in JSON.
+func (b *LenientBool) UnmarshalJSON(data []byte) error {
+	text, _ := unquoteJSON(data)
+	if string(text) == "null" {
+		return nil
+	}
+
+	return b.UnmarshalText(text)
+}
+
+// ParseBoolLenient converts string to bool with DefaultTrueValues and DefaultFalseValues.
+// It is case-insensitive and ignores the leading and trailing white space, e.g. " Yes " is true and "OFF" is false.
+func ParseBoolLenient(str string) (bool, error) {
+	return ParseBoolWithOptions(str, BoolOptions{})
+}
+
+// ParseBoolWithOptions converts string to bool with the values of options. The leading and trailing white space is ignored.
+func ParseBoolWithOptions(str string, opts BoolOptions) (bool, error) {
+	trueValues, falseValues := opts.TrueValues, opts.FalseValues
+	if len(trueValues) == 0 {
+		trueValues = DefaultTrueValues
+	}
+	if len(falseValues) == 0 {
+		falseValues = DefaultFalseValues
+	}
+
+	value := strings.TrimSpace(str)
+	if containsValue(trueValues, value, opts.CaseSensitive) {
+		return true, nil
+	}
+	if containsValue(falseValues, value, opts.CaseSensitive) {
+		return false, nil
+	}
+
+	return false, ero.Newf("Invalid bool %q, expected one of: %s", str, strings.Join(append(append([]string(nil), trueValues...), falseValues...), ", "))
+}
+
+func containsValue(values []string, value string, caseSensitive bool) bool {
+	for _, candidate := range values {
+		if candidate == value || !caseSensitive && strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+
+	return false
+}
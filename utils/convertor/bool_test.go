@@ -0,0 +1,84 @@
+package conv
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseBoolLenient_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str      string
+		expected bool
+	}{
+		{str: "1", expected: true},
+		{str: "TRUE", expected: true},
+		{str: "yes", expected: true},
+		{str: " Y ", expected: true},
+		{str: "On", expected: true},
+		{str: "enabled", expected: true},
+		{str: "0", expected: false},
+		{str: "False", expected: false},
+		{str: "NO", expected: false},
+		{str: "n", expected: false},
+		{str: "off", expected: false},
+		{str: "Disabled", expected: false},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseBoolLenient(table.str)
+
+		// THEN
+		assert.Nil(err, table.str)
+		assert.Equal(table.expected, actual, table.str)
+	}
+}
+
+func TestParseBoolWithOptions_MultipleCase(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	opts := BoolOptions{TrueValues: []string{"Y"}, FalseValues: []string{"N"}, CaseSensitive: true}
+
+	// WHEN
+	yes, errYes := ParseBoolWithOptions("Y", opts)
+	no, errNo := ParseBoolWithOptions("N", opts)
+	_, errLower := ParseBoolWithOptions("y", opts)
+	_, errDefault := ParseBoolLenient("maybe")
+
+	// THEN
+	assert.Nil(errYes)
+	assert.Nil(errNo)
+	assert.True(yes)
+	assert.False(no)
+	assert.Equal("Invalid bool \"y\", expected one of: Y, N", errLower.Error())
+	assert.Equal("Invalid bool \"maybe\", expected one of: 1, t, true, y, yes, on, enable, enabled, 0, f, false, n, no, off, disable, disabled", errDefault.Error())
+}
+
+func TestLenientBool_YAMLAndJSON_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	type config struct {
+		Debug   LenientBool `yaml:"debug" json:"debug"`
+		Verbose LenientBool `yaml:"verbose" json:"verbose"`
+		Trace   LenientBool `yaml:"trace" json:"trace"`
+	}
+	fromYAML := config{Trace: true}
+	fromJSON := config{Trace: true}
+
+	// WHEN
+	errYAML := yaml.Unmarshal([]byte("debug: on\nverbose: \"N\"\n"), &fromYAML)
+	errJSON := json.Unmarshal([]byte(`{"debug": "yes", "verbose": false, "trace": null}`), &fromJSON)
+	errInvalid := json.Unmarshal([]byte(`{"debug": "maybe"}`), &fromJSON)
+
+	// THEN
+	assert.Nil(errYAML)
+	assert.Nil(errJSON)
+	assert.Equal(config{Debug: true, Verbose: false, Trace: true}, fromYAML)
+	assert.Equal(config{Debug: true, Verbose: false, Trace: true}, fromJSON)
+	assert.NotNil(errInvalid)
+}
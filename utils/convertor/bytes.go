@@ -0,0 +1,154 @@
+package conv
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// ByteSize is the size in bytes which can be used as a field of YAML or JSON config such as "512MiB" or "2GB".
+//
+// 	type Config struct {
+// 		MaxSize conv.ByteSize `yaml:"max_size" json:"max_size"` // max_size: 512MiB
+// 	}
+type ByteSize int64
+
+// The SI units are the powers of 1000 and the IEC units are the powers of 1024.
+const (
+	Byte ByteSize = 1
+
+	KB ByteSize = 1000 * Byte
+	MB ByteSize = 1000 * KB
+	GB ByteSize = 1000 * MB
+	TB ByteSize = 1000 * GB
+	PB ByteSize = 1000 * TB
+	EB ByteSize = 1000 * PB
+
+	KiB ByteSize = 1024 * Byte
+	MiB ByteSize = 1024 * KiB
+	GiB ByteSize = 1024 * MiB
+	TiB ByteSize = 1024 * GiB
+	PiB ByteSize = 1024 * TiB
+	EiB ByteSize = 1024 * PiB
+)
+
+// ByteStandard is the standard of units used by FormatBytes.
+type ByteStandard int
+
+const (
+	// BytesIEC formats the size in the powers of 1024 such as "1.5GiB".
+	BytesIEC ByteStandard = iota
+	// BytesSI formats the size in the powers of 1000 such as "1.5GB".
+	BytesSI
+)
+
+type byteUnit struct {
+	symbol string
+	size   ByteSize
+}
+
+var (
+	iecUnits = []byteUnit{{"EiB", EiB}, {"PiB", PiB}, {"TiB", TiB}, {"GiB", GiB}, {"MiB", MiB}, {"KiB", KiB}, {"B", Byte}}
+	siUnits  = []byteUnit{{"EB", EB}, {"PB", PB}, {"TB", TB}, {"GB", GB}, {"MB", MB}, {"KB", KB}, {"B", Byte}}
+
+	byteUnits = map[string]ByteSize{
+		"": Byte, "b": Byte,
+		"k": KB, "kb": KB, "ki": KiB, "kib": KiB,
+		"m": MB, "mb": MB, "mi": MiB, "mib": MiB,
+		"g": GB, "gb": GB, "gi": GiB, "gib": GiB,
+		"t": TB, "tb": TB, "ti": TiB, "tib": TiB,
+		"p": PB, "pb": PB, "pi": PiB, "pib": PiB,
+		"e": EB, "eb": EB, "ei": EiB, "eib": EiB,
+	}
+)
+
+// String returns the size in IEC units such as "1.5GiB".
+func (s ByteSize) String() string {
+	return FormatBytes(int64(s), BytesIEC)
+}
+
+// MarshalText implements encoding.TextMarshaler. The size is written exactly in the largest IEC unit dividing it such as "512MiB",
+// or in bytes such as "1000B".
+func (s ByteSize) MarshalText() ([]byte, error) {
+	unit := iecUnits[len(iecUnits)-1]
+	for _, candidate := range iecUnits {
+		if s != 0 && s%candidate.size == 0 {
+			unit = candidate
+			break
+		}
+	}
+
+	return []byte(strconv.FormatInt(int64(s/unit.size), 10) + unit.symbol), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, so the size can be read from "512MiB" or "2GB" in YAML and JSON.
+func (s *ByteSize) UnmarshalText(text []byte) error {
+	size, err := ParseBytes(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = ByteSize(size)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, so the size can be read from both "512MiB" and 536870912 in JSON.
+func (s *ByteSize) UnmarshalJSON(data []byte) error {
+	text, _ := unquoteJSON(data)
+	if string(text) == "null" {
+		return nil
+	}
+
+	return s.UnmarshalText(text)
+}
+
+// ParseBytes converts the human-readable size to bytes such as "1.5GiB" to 1610612736 or "2GB" to 2000000000.
+// The units are case-insensitive: B, KB, MB, GB, TB, PB and EB are the powers of 1000, KiB, MiB, GiB, TiB, PiB and EiB are the powers of 1024.
+// The "B" can be omitted such as "512Mi" or "10k", and the number without unit is in bytes. The fraction of bytes is rounded down.
+func ParseBytes(str string) (int64, error) {
+	number, unit := splitQuantity(str)
+	size, ok := byteUnits[strings.ToLower(unit)]
+	if !ok {
+		return 0, ero.Newf("Unknown unit %q in byte size %q", unit, str)
+	}
+	if strings.HasPrefix(number, "-") {
+		return 0, ero.Newf("Byte size %q must not be negative", str)
+	}
+
+	rat, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return 0, ero.Newf("Invalid byte size %q", str)
+	}
+
+	rat.Mul(rat, new(big.Rat).SetInt64(int64(size)))
+	bytes := new(big.Int).Quo(rat.Num(), rat.Denom())
+	if !bytes.IsInt64() {
+		return 0, ero.Newf("Byte size %q overflows", str)
+	}
+
+	return bytes.Int64(), nil
+}
+
+// FormatBytes converts the size in bytes to the human-readable string with at most 2 decimals such as "1.5GiB" or "1.61GB".
+func FormatBytes(size int64, standard ByteStandard) string {
+	units := iecUnits
+	if standard == BytesSI {
+		units = siUnits
+	}
+
+	abs := float64(size)
+	if abs < 0 {
+		abs = -abs
+	}
+	unit := units[len(units)-1]
+	for _, candidate := range units {
+		if abs >= float64(candidate.size) {
+			unit = candidate
+			break
+		}
+	}
+
+	return formatFloat(float64(size)/float64(unit.size), 64, FloatFormat{Verb: 'f', Precision: 2, TrimZeros: true}) + unit.symbol
+}
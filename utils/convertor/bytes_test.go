@@ -0,0 +1,148 @@
+package conv
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseBytes_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str      string
+		expected int64
+	}{
+		{str: "0", expected: 0},
+		{str: "1024", expected: 1024},
+		{str: "100B", expected: 100},
+		{str: "2GB", expected: 2000000000},
+		{str: "1.5GiB", expected: 1610612736},
+		{str: "512MiB", expected: 536870912},
+		{str: "512Mi", expected: 536870912},
+		{str: " 10 kb ", expected: 10000},
+		{str: "10k", expected: 10000},
+		{str: "+1KiB", expected: 1024},
+		{str: ".5KiB", expected: 512},
+		{str: "1.0005KB", expected: 1000},
+		{str: "1.5EiB", expected: 1729382256910270464},
+		{str: "9223372036854775807", expected: 9223372036854775807},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseBytes(table.str)
+
+		// THEN
+		assert.Nil(err, table.str)
+		assert.Equal(table.expected, actual, table.str)
+	}
+}
+
+func TestParseBytes_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str         string
+		expectedErr string
+	}{
+		{str: "", expectedErr: "Invalid byte size \"\""},
+		{str: "GB", expectedErr: "Invalid byte size \"GB\""},
+		{str: "1.2.3MB", expectedErr: "Invalid byte size \"1.2.3MB\""},
+		{str: "10XB", expectedErr: "Unknown unit \"XB\" in byte size \"10XB\""},
+		{str: "-1MB", expectedErr: "Byte size \"-1MB\" must not be negative"},
+		{str: "8EiB", expectedErr: "Byte size \"8EiB\" overflows"},
+		{str: "9223372036854775808", expectedErr: "Byte size \"9223372036854775808\" overflows"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		_, err := ParseBytes(table.str)
+
+		// THEN
+		assert.NotNil(err, table.str)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+}
+
+func TestFormatBytes_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		size     int64
+		standard ByteStandard
+		expected string
+	}{
+		{size: 0, standard: BytesIEC, expected: "0B"},
+		{size: 1023, standard: BytesIEC, expected: "1023B"},
+		{size: 1536, standard: BytesIEC, expected: "1.5KiB"},
+		{size: 1610612736, standard: BytesIEC, expected: "1.5GiB"},
+		{size: 1610612736, standard: BytesSI, expected: "1.61GB"},
+		{size: 2000000000, standard: BytesSI, expected: "2GB"},
+		{size: -1536, standard: BytesIEC, expected: "-1.5KiB"},
+		{size: 9223372036854775807, standard: BytesIEC, expected: "8EiB"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := FormatBytes(table.size, table.standard)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func TestByteSize_YAMLAndJSON_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	type config struct {
+		MaxSize ByteSize `yaml:"max_size" json:"max_size"`
+		Buffer  ByteSize `yaml:"buffer" json:"buffer"`
+		Limit   ByteSize `yaml:"limit" json:"limit"`
+	}
+	var fromYAML, fromJSON config
+
+	// WHEN
+	errYAML := yaml.Unmarshal([]byte("max_size: 512MiB\nbuffer: 4096\nlimit: 2GB\n"), &fromYAML)
+	errJSON := json.Unmarshal([]byte(`{"max_size": "512MiB", "buffer": 4096, "limit": null}`), &fromJSON)
+	outYAML, errOutYAML := yaml.Marshal(config{MaxSize: 512 * MiB, Buffer: 1000, Limit: 2 * GB})
+	outJSON, errOutJSON := json.Marshal(config{MaxSize: 512 * MiB, Buffer: 4 * KiB})
+
+	// THEN
+	assert.Nil(errYAML)
+	assert.Nil(errJSON)
+	assert.Nil(errOutYAML)
+	assert.Nil(errOutJSON)
+	assert.Equal(config{MaxSize: 512 * MiB, Buffer: 4 * KiB, Limit: 2 * GB}, fromYAML)
+	assert.Equal(config{MaxSize: 512 * MiB, Buffer: 4 * KiB}, fromJSON)
+	assert.Equal("max_size: 512MiB\nbuffer: 1000B\nlimit: 1953125KiB\n", string(outYAML))
+	assert.Equal(`{"max_size":"512MiB","buffer":"4KiB","limit":"0B"}`, string(outJSON))
+	assert.Equal("1.5GiB", (GiB + 512*MiB).String())
+}
+
+func TestByteSize_InvalidConfig_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	var size struct {
+		MaxSize ByteSize `yaml:"max_size" json:"max_size"`
+	}
+
+	// WHEN
+	errYAML := yaml.Unmarshal([]byte("max_size: 512XB\n"), &size)
+	errJSON := json.Unmarshal([]byte(`{"max_size": true}`), &size)
+
+	// THEN
+	assert.NotNil(errYAML)
+	assert.Equal("Unknown unit \"true\" in byte size \"true\"", errJSON.Error())
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseBytes("1.5GiB")
+	}
+}
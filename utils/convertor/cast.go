@@ -0,0 +1,176 @@
+package conv
+
+import (
+	"math"
+	"unsafe"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// Cast converts the number to the integer type To, and returns an error instead of overflowing silently:
+// the value out of range of To, the negative value to unsigned type, NaN or infinity.
+// The fractional part of floats is truncated toward zero such as 2.9 to 2.
+//
+// 	id32, err := conv.Cast[int32](id64)
+// 	n, err := conv.Cast[uint](-1) // Negative value -1 cannot be converted to uint
+func Cast[To Integer, From Number](value From) (To, error) {
+	min, max := integerBounds[To]()
+
+	if isFloatType(value) {
+		f := float64(value)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, ero.Newf("Value %v cannot be converted to %T", f, To(0))
+		}
+
+		f = math.Trunc(f)
+		switch {
+		case f < 0 && min == 0:
+			return 0, ero.Newf("Negative value %v cannot be converted to %T", value, To(0))
+		case f < float64(min) || f >= exclusiveMax[To]():
+			return 0, ero.Newf("Value %v overflows %T", value, To(0))
+		}
+		return To(f), nil
+	}
+
+	if value < 0 {
+		i64 := int64(value)
+		switch {
+		case min == 0:
+			return 0, ero.Newf("Negative value %v cannot be converted to %T", value, To(0))
+		case i64 < min:
+			return 0, ero.Newf("Value %v overflows %T", value, To(0))
+		}
+		return To(i64), nil
+	}
+
+	if uint64(value) > max {
+		return 0, ero.Newf("Value %v overflows %T", value, To(0))
+	}
+
+	return To(value), nil
+}
+
+// MustCast is like Cast but panics if the value cannot be converted.
+func MustCast[To Integer, From Number](value From) To {
+	result, err := Cast[To](value)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// Saturate converts the number to the integer type To, and clamps the value out of range to the min or max value of To,
+// which is suitable for metrics. NaN is 0 and the fractional part of floats is truncated toward zero.
+//
+// 	conv.Saturate[int8](300)    // 127
+// 	conv.Saturate[uint32](-5)   // 0
+// 	conv.Saturate[int64](1e300) // math.MaxInt64
+func Saturate[To Integer, From Number](value From) To {
+	min, max := integerBounds[To]()
+
+	if isFloatType(value) {
+		f := float64(value)
+		switch {
+		case math.IsNaN(f):
+			return 0
+		case f < float64(min):
+			return To(min)
+		case f >= exclusiveMax[To]():
+			return To(max)
+		}
+		return To(f)
+	}
+
+	if value < 0 {
+		if i64 := int64(value); i64 > min {
+			return To(i64)
+		}
+		return To(min)
+	}
+
+	if uint64(value) > max {
+		return To(max)
+	}
+
+	return To(value)
+}
+
+// ToInt converts the number to int. See Cast.
+func ToInt[T Number](value T) (int, error) {
+	return Cast[int](value)
+}
+
+// ToInt8 converts the number to int8. See Cast.
+func ToInt8[T Number](value T) (int8, error) {
+	return Cast[int8](value)
+}
+
+// ToInt16 converts the number to int16. See Cast.
+func ToInt16[T Number](value T) (int16, error) {
+	return Cast[int16](value)
+}
+
+// ToInt32 converts the number to int32. See Cast.
+func ToInt32[T Number](value T) (int32, error) {
+	return Cast[int32](value)
+}
+
+// ToInt64 converts the number to int64. See Cast.
+func ToInt64[T Number](value T) (int64, error) {
+	return Cast[int64](value)
+}
+
+// ToUint converts the number to uint. See Cast.
+func ToUint[T Number](value T) (uint, error) {
+	return Cast[uint](value)
+}
+
+// ToUint8 converts the number to uint8. See Cast.
+func ToUint8[T Number](value T) (uint8, error) {
+	return Cast[uint8](value)
+}
+
+// ToUint16 converts the number to uint16. See Cast.
+func ToUint16[T Number](value T) (uint16, error) {
+	return Cast[uint16](value)
+}
+
+// ToUint32 converts the number to uint32. See Cast.
+func ToUint32[T Number](value T) (uint32, error) {
+	return Cast[uint32](value)
+}
+
+// ToUint64 converts the number to uint64. See Cast.
+func ToUint64[T Number](value T) (uint64, error) {
+	return Cast[uint64](value)
+}
+
+// Float64ToInt64 converts float64 to int64 with the fractional part truncated.
+// It returns an error if the float is NaN, infinity or out of range of int64.
+func Float64ToInt64(value float64) (int64, error) {
+	return Cast[int64](value)
+}
+
+// integerBounds returns the min and max values of T.
+func integerBounds[T Integer]() (int64, uint64) {
+	var zero T
+	bits := uint(unsafe.Sizeof(zero)) * 8
+	if zero-1 < 0 {
+		return -1 << (bits - 1), 1<<(bits-1) - 1
+	}
+
+	return 0, math.MaxUint64 >> (64 - bits)
+}
+
+// exclusiveMax returns the max value of T plus 1 as float64, which is a power of 2 and exact,
+// unlike float64(math.MaxInt64) which is rounded up to 2^63.
+func exclusiveMax[T Integer]() float64 {
+	var zero T
+	bits := int(unsafe.Sizeof(zero)) * 8
+	if zero-1 < 0 {
+		return math.Ldexp(1, bits-1)
+	}
+
+	return math.Ldexp(1, bits)
+}
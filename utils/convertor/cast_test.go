@@ -0,0 +1,106 @@
+package conv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCast_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	i32, errInt32 := ToInt32(int64(math.MaxInt32))
+	minInt32, errMinInt32 := ToInt32(int64(math.MinInt32))
+	ui, errUInt := ToUint(42)
+	ui64, errUInt64 := ToUint64(uint(math.MaxUint64))
+	i8, errInt8 := ToInt8(uint64(127))
+	i64, errFloat := Float64ToInt64(-2.9)
+	maxInt64, errMaxInt64 := ToInt64(uint64(math.MaxInt64))
+	u8, errFloat32 := ToUint8(float32(255.5))
+	p, errPort := Cast[port](int64(8080))
+
+	// THEN
+	assert.Nil(errInt32)
+	assert.Nil(errMinInt32)
+	assert.Nil(errUInt)
+	assert.Nil(errUInt64)
+	assert.Nil(errInt8)
+	assert.Nil(errFloat)
+	assert.Nil(errMaxInt64)
+	assert.Nil(errFloat32)
+	assert.Nil(errPort)
+	assert.Equal(int32(math.MaxInt32), i32)
+	assert.Equal(int32(math.MinInt32), minInt32)
+	assert.Equal(uint(42), ui)
+	assert.Equal(uint64(math.MaxUint64), ui64)
+	assert.Equal(int8(127), i8)
+	assert.Equal(int64(-2), i64)
+	assert.Equal(int64(math.MaxInt64), maxInt64)
+	assert.Equal(uint8(255), u8)
+	assert.Equal(port(8080), p)
+}
+
+func TestCast_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	_, errOverflow := ToInt32(int64(math.MaxInt32) + 1)
+	_, errUnderflow := ToInt32(int64(math.MinInt32) - 1)
+	_, errNegative := ToUint(-1)
+	_, errUnsigned := ToInt64(uint64(math.MaxUint64))
+	_, errInt8 := ToInt8(uint8(128))
+	_, errNaN := Float64ToInt64(math.NaN())
+	_, errInf := Float64ToInt64(math.Inf(-1))
+	_, errFloatOverflow := Float64ToInt64(9223372036854775807.0)
+	_, errFloatNegative := ToUint32(-1.5)
+	_, errPort := Cast[port](70000)
+
+	// THEN
+	assert.Equal("Value 2147483648 overflows int32", errOverflow.Error())
+	assert.Equal("Value -2147483649 overflows int32", errUnderflow.Error())
+	assert.Equal("Negative value -1 cannot be converted to uint", errNegative.Error())
+	assert.Equal("Value 18446744073709551615 overflows int64", errUnsigned.Error())
+	assert.Equal("Value 128 overflows int8", errInt8.Error())
+	assert.Equal("Value NaN cannot be converted to int64", errNaN.Error())
+	assert.Equal("Value -Inf cannot be converted to int64", errInf.Error())
+	assert.Equal("Value 9.223372036854776e+18 overflows int64", errFloatOverflow.Error())
+	assert.Equal("Negative value -1.5 cannot be converted to uint32", errFloatNegative.Error())
+	assert.Equal("Value 70000 overflows conv.port", errPort.Error())
+	assert.Equal(int16(-5), MustCast[int16](-5))
+	assert.Panics(func() {
+		MustCast[uint16](-5)
+	})
+}
+
+func TestSaturate_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	// THEN
+	assert.Equal(int8(127), Saturate[int8](300))
+	assert.Equal(int8(-128), Saturate[int8](-300))
+	assert.Equal(int8(-5), Saturate[int8](-5))
+	assert.Equal(uint32(0), Saturate[uint32](-5))
+	assert.Equal(uint32(math.MaxUint32), Saturate[uint32](uint64(math.MaxUint64)))
+	assert.Equal(int64(math.MaxInt64), Saturate[int64](uint64(math.MaxUint64)))
+	assert.Equal(int64(math.MaxInt64), Saturate[int64](1e300))
+	assert.Equal(int64(math.MinInt64), Saturate[int64](math.Inf(-1)))
+	assert.Equal(int64(0), Saturate[int64](math.NaN()))
+	assert.Equal(uint8(0), Saturate[uint8](-0.5))
+	assert.Equal(int32(2), Saturate[int32](float32(2.9)))
+	assert.Equal(port(65535), Saturate[port](1<<20))
+}
+
+func BenchmarkCast(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = Cast[int32](int64(i))
+	}
+}
@@ -1,168 +1,143 @@
 package conv
 
-import "strconv"
-
 // ConvertStringToInt converts string to int
 func ConvertStringToInt(numStr string) (int, error) {
-	i64, err := convertStringToNumber(numStr, 0)
-	return int(i64), err
+	return Parse[int](numStr)
 }
 
 // ConvertStringToInt8 converts string to int8
 func ConvertStringToInt8(numStr string) (int8, error) {
-	i64, err := convertStringToNumber(numStr, 8)
-	return int8(i64), err
+	return Parse[int8](numStr)
 }
 
 // ConvertStringToInt16 converts string to int16
 func ConvertStringToInt16(numStr string) (int16, error) {
-	i64, err := convertStringToNumber(numStr, 16)
-	return int16(i64), err
+	return Parse[int16](numStr)
 }
 
 // ConvertStringToInt32 converts string to int32
 func ConvertStringToInt32(numStr string) (int32, error) {
-	i64, err := convertStringToNumber(numStr, 32)
-	return int32(i64), err
+	return Parse[int32](numStr)
 }
 
 // ConvertStringToInt64 converts string to int64
 func ConvertStringToInt64(numStr string) (int64, error) {
-	return convertStringToNumber(numStr, 64)
+	return Parse[int64](numStr)
 }
 
 // ConvertStringToUInt converts string to uint
 func ConvertStringToUInt(numStr string) (uint, error) {
-	ui64, err := convertStringToUNumber(numStr, 0)
-	return uint(ui64), err
+	return Parse[uint](numStr)
 }
 
 // ConvertStringToUInt8 converts string to uint8
 func ConvertStringToUInt8(numStr string) (uint8, error) {
-	ui64, err := convertStringToUNumber(numStr, 8)
-	return uint8(ui64), err
+	return Parse[uint8](numStr)
 }
 
 // ConvertStringToUInt16 converts string to uint16
 func ConvertStringToUInt16(numStr string) (uint16, error) {
-	ui64, err := convertStringToUNumber(numStr, 16)
-	return uint16(ui64), err
+	return Parse[uint16](numStr)
 }
 
 // ConvertStringToUInt32 converts string to uint32
 func ConvertStringToUInt32(numStr string) (uint32, error) {
-	ui64, err := convertStringToUNumber(numStr, 32)
-	return uint32(ui64), err
+	return Parse[uint32](numStr)
 }
 
 // ConvertStringToUInt64 converts string to uint64
 func ConvertStringToUInt64(numStr string) (uint64, error) {
-	return convertStringToUNumber(numStr, 64)
+	return Parse[uint64](numStr)
 }
 
 // ConvertStringToBool converts string to bool.
 // It accepts 1, t, T, TRUE, true, True, 0, f, F, FALSE, false, False.
 // Any other value returns an error.
 func ConvertStringToBool(boolStr string) (bool, error) {
-	return strconv.ParseBool(boolStr)
+	return Parse[bool](boolStr)
 }
 
 // ConvertStringToFloat32 converts string to float32
 func ConvertStringToFloat32(numStr string) (float32, error) {
-	f64, err := convertStringToFloat(numStr, 32)
-	return float32(f64), err
+	return Parse[float32](numStr)
 }
 
 // ConvertStringToFloat64 converts string to float64
 func ConvertStringToFloat64(numStr string) (float64, error) {
-	return convertStringToFloat(numStr, 64)
+	return Parse[float64](numStr)
 }
 
 // ConvertIntToString converts int to string
 func ConvertIntToString(num int) string {
-	return convertNumberToString(int64(num))
+	return Format(num)
 }
 
 // ConvertInt8ToString converts int8 to string
 func ConvertInt8ToString(num int8) string {
-	return convertNumberToString(int64(num))
+	return Format(num)
 }
 
 // ConvertInt16ToString converts int16 to string
 func ConvertInt16ToString(num int16) string {
-	return convertNumberToString(int64(num))
+	return Format(num)
 }
 
 // ConvertInt32ToString converts int32 to string
 func ConvertInt32ToString(num int32) string {
-	return convertNumberToString(int64(num))
+	return Format(num)
 }
 
 // ConvertInt64ToString converts int64 to string
 func ConvertInt64ToString(num int64) string {
-	return convertNumberToString(num)
+	return Format(num)
 }
 
 // ConvertUIntToString converts uint to string
 func ConvertUIntToString(num uint) string {
-	return convertUNumberToString(uint64(num))
+	return Format(num)
 }
 
 // ConvertUInt8ToString converts uint to string
 func ConvertUInt8ToString(num uint8) string {
-	return convertUNumberToString(uint64(num))
+	return Format(num)
 }
 
 // ConvertUInt16ToString converts uint to string
 func ConvertUInt16ToString(num uint16) string {
-	return convertUNumberToString(uint64(num))
+	return Format(num)
 }
 
 // ConvertUInt32ToString converts uint to string
 func ConvertUInt32ToString(num uint32) string {
-	return convertUNumberToString(uint64(num))
+	return Format(num)
 }
 
 // ConvertUInt64ToString converts uint to string
 func ConvertUInt64ToString(num uint64) string {
-	return convertUNumberToString(num)
+	return Format(num)
 }
 
 // ConvertBoolToString converts bool to string. It returns "true", "false"
 func ConvertBoolToString(b bool) string {
-	return strconv.FormatBool(b)
-}
-
-// ConvertFloat32ToString convert float32 to string
-func ConvertFloat32ToString(num float32) string {
-	return convertFloatToString(float64(num), 32)
+	return Format(b)
 }
 
-// ConvertFloat64ToString convert float32 to string
-func ConvertFloat64ToString(num float64) string {
-	return convertFloatToString(num, 64)
-}
-
-func convertStringToNumber(numStr string, bitSize int) (int64, error) {
-	return strconv.ParseInt(numStr, 10, bitSize)
-}
+// ConvertFloat32ToString convert float32 to string with DefaultFloatFormat, e.g. "1.5E+00".
+// If format is given, it is used instead of DefaultFloatFormat, e.g. ConvertFloat32ToString(1.5, PlainFloatFormat) is "1.5".
+func ConvertFloat32ToString(num float32, format ...FloatFormat) string {
+	if len(format) > 0 {
+		return FormatFloat(num, format[0])
+	}
 
-func convertStringToUNumber(numStr string, bitSize int) (uint64, error) {
-	return strconv.ParseUint(numStr, 10, bitSize)
+	return Format(num)
 }
 
-func convertStringToFloat(numStr string, bitSize int) (float64, error) {
-	return strconv.ParseFloat(numStr, bitSize)
-}
-
-func convertNumberToString(num int64) string {
-	return strconv.FormatInt(num, 10)
-}
-
-func convertUNumberToString(num uint64) string {
-	return strconv.FormatUint(num, 10)
-}
+// ConvertFloat64ToString convert float64 to string with DefaultFloatFormat, e.g. "1.5E+00".
+// If format is given, it is used instead of DefaultFloatFormat, e.g. ConvertFloat64ToString(1.5, PlainFloatFormat) is "1.5".
+func ConvertFloat64ToString(num float64, format ...FloatFormat) string {
+	if len(format) > 0 {
+		return FormatFloat(num, format[0])
+	}
 
-func convertFloatToString(num float64, bitSize int) string {
-	return strconv.FormatFloat(num, 'E', -1, bitSize)
+	return Format(num)
 }
@@ -0,0 +1,398 @@
+package conv
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"strconv"
+	"strings"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// maxDecimalScale limits the digits after the decimal point and the exponent of ParseDecimal,
+// so that "1e1000000000" cannot allocate a huge number.
+const maxDecimalScale = 1 << 16
+
+// RoundingMode is the mode to round the decimal to a scale.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest, and away from zero if it is halfway, e.g. 2.5 is 3 and -2.5 is -3.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest, and to the even neighbour if it is halfway, e.g. 2.5 is 2 and 3.5 is 4. It is the banker's rounding.
+	RoundHalfEven
+	// RoundDown rounds toward zero, e.g. 2.9 is 2 and -2.9 is -2.
+	RoundDown
+	// RoundUp rounds away from zero, e.g. 2.1 is 3 and -2.1 is -3.
+	RoundUp
+	// RoundCeiling rounds toward positive infinity, e.g. 2.1 is 3 and -2.9 is -2.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity, e.g. 2.9 is 2 and -2.1 is -3.
+	RoundFloor
+)
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an exact decimal number such as an amount of money, which is value * 10^-scale.
+// The scale is kept by the operations, e.g. "12.50" is printed as "12.50". The zero value is 0.
+// Decimal is immutable, the operations return new decimals.
+type Decimal struct {
+	value *big.Int
+	scale int
+}
+
+// NewDecimal returns the decimal value * 10^-scale, e.g. NewDecimal(1250, 2) is 12.50.
+func NewDecimal(value int64, scale int) Decimal {
+	if scale < 0 {
+		return Decimal{value: new(big.Int).Mul(big.NewInt(value), pow10(-scale))}
+	}
+
+	return Decimal{value: big.NewInt(value), scale: scale}
+}
+
+// NewDecimalFromFloat returns the decimal of the shortest string representing the float exactly, e.g. 0.1 is 0.1.
+// It returns an error if the float is NaN or infinity.
+func NewDecimalFromFloat(value float64) (Decimal, error) {
+	return ParseDecimal(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// ParseDecimal converts string to decimal exactly such as "-12.50", "+0.001" or "1.5e3".
+// The scale of the decimal is the number of digits after the decimal point minus the exponent, not less than 0.
+func ParseDecimal(str string) (Decimal, error) {
+	mantissa, exponent := str, 0
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(str[i+1:])
+		if err != nil || exp > maxDecimalScale || exp < -maxDecimalScale {
+			return Decimal{}, ero.Newf("Invalid decimal %q", str)
+		}
+		mantissa, exponent = str[:i], exp
+	}
+
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '+' || mantissa[0] == '-') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	integer, fraction := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		integer, fraction = mantissa[:i], mantissa[i+1:]
+	}
+	digits := integer + fraction
+	if digits == "" || len(fraction) > maxDecimalScale || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, ero.Newf("Invalid decimal %q", str)
+	}
+
+	value, _ := new(big.Int).SetString(sign+digits, 10)
+	scale := len(fraction) - exponent
+	if scale < 0 {
+		return Decimal{value: value.Mul(value, pow10(-scale))}, nil
+	}
+
+	return Decimal{value: value, scale: scale}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if the string is invalid.
+func MustParseDecimal(str string) Decimal {
+	d, err := ParseDecimal(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// String returns the decimal without exponent and with all digits of the scale such as "12.50".
+func (d Decimal) String() string {
+	digits := d.coefficient().String()
+	sign := ""
+	if digits[0] == '-' {
+		sign, digits = "-", digits[1:]
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Sign returns -1, 0 or 1 if the decimal is negative, zero or positive.
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+// IsZero reports whether the decimal is zero.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp returns -1, 0 or 1 if d is less than, equal to or greater than other. The scale is ignored, e.g. 12.5 equals 12.50.
+func (d Decimal) Cmp(other Decimal) int {
+	x, y := align(d, other)
+	return x.Cmp(y)
+}
+
+// Equal reports whether d and other are the same number regardless of the scale.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: new(big.Int).Neg(d.coefficient()), scale: d.scale}
+}
+
+// Abs returns |d|.
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Int).Abs(d.coefficient()), scale: d.scale}
+}
+
+// Add returns d + other with the larger scale of them.
+func (d Decimal) Add(other Decimal) Decimal {
+	x, y := align(d, other)
+	return Decimal{value: x.Add(x, y), scale: maxInt(d.scale, other.scale)}
+}
+
+// Sub returns d - other with the larger scale of them.
+func (d Decimal) Sub(other Decimal) Decimal {
+	x, y := align(d, other)
+	return Decimal{value: x.Sub(x, y), scale: maxInt(d.scale, other.scale)}
+}
+
+// Mul returns d * other with the sum of their scales, e.g. 1.25 * 0.1 is 0.125.
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{value: new(big.Int).Mul(d.coefficient(), other.coefficient()), scale: d.scale + other.scale}
+}
+
+// Div returns d / other rounded to the scale with the mode, e.g. 10 / 3 to the scale 2 is 3.33.
+// It returns an error if other is zero or the scale is negative.
+func (d Decimal) Div(other Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, ero.New("Division by zero")
+	}
+	if scale < 0 {
+		return Decimal{}, ero.Newf("Scale %d must not be negative", scale)
+	}
+
+	// d / other = (x * 10^-s1) / (y * 10^-s2), so the result in the scale is x * 10^(scale+s2-s1) / y.
+	numerator := new(big.Int).Set(d.coefficient())
+	denominator := new(big.Int).Set(other.coefficient())
+	if shift := scale + other.scale - d.scale; shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+
+	return Decimal{value: roundQuotient(numerator, denominator, mode), scale: scale}, nil
+}
+
+// Round returns d rounded to the scale with the mode, e.g. 2.345 rounded to the scale 2 is 2.35 with RoundHalfUp.
+// The larger scale appends zeros such as 2.5 to 2.500, and the negative scale rounds to tens, hundreds and so on.
+func (d Decimal) Round(scale int, mode RoundingMode) Decimal {
+	if scale >= d.scale {
+		return Decimal{value: new(big.Int).Mul(d.coefficient(), pow10(scale-d.scale)), scale: scale}
+	}
+
+	value := roundQuotient(d.coefficient(), pow10(d.scale-scale), mode)
+	if scale < 0 {
+		return Decimal{value: value.Mul(value, pow10(-scale))}
+	}
+
+	return Decimal{value: value, scale: scale}
+}
+
+// Float64 returns the nearest float64 of the decimal.
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(d.coefficient(), pow10(d.scale)).Float64()
+	return f
+}
+
+// MarshalText implements encoding.TextMarshaler, so the decimal is written as "12.50" in YAML.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, so the decimal can be read from both 12.50 and "12.50" in YAML without rounding.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	value, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = value
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The decimal is written as the string "12.50",
+// since the JSON numbers are decoded to float64 by many clients.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, so the decimal can be read from both 12.50 and "12.50" in JSON without rounding.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	text, _ := unquoteJSON(data)
+	if string(text) == "null" {
+		return nil
+	}
+
+	return d.UnmarshalText(text)
+}
+
+// Scan implements sql.Scanner, so the decimal can be read from the DECIMAL columns of MySQL.
+func (d *Decimal) Scan(src interface{}) error {
+	var value Decimal
+	var err error
+	switch v := src.(type) {
+	case []byte:
+		value, err = ParseDecimal(string(v))
+	case string:
+		value, err = ParseDecimal(v)
+	case int64:
+		value = NewDecimal(v, 0)
+	case float64:
+		value, err = NewDecimalFromFloat(v)
+	case nil:
+		return ero.New("Cannot scan NULL into Decimal, use NullDecimal for the nullable columns")
+	default:
+		return ero.Newf("Cannot scan %T into Decimal", src)
+	}
+	if err != nil {
+		return err
+	}
+
+	*d = value
+	return nil
+}
+
+// Value implements driver.Valuer, so the decimal is written to the DECIMAL columns of MySQL as the string "12.50".
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// NullDecimal is a Decimal that may be NULL, which is the same as sql.NullString for the nullable DECIMAL columns of MySQL.
+//
+// 	var discount conv.NullDecimal
+// 	err := row.Scan(&discount)
+// 	if discount.Valid {
+// 		total = total.Sub(discount.Decimal)
+// 	}
+type NullDecimal struct {
+	Decimal Decimal
+	// Valid is true if Decimal is not NULL.
+	Valid bool
+}
+
+// Scan implements sql.Scanner. NULL sets Valid to false.
+func (n *NullDecimal) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullDecimal{}
+		return nil
+	}
+
+	if err := n.Decimal.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer. It returns nil if the decimal is NULL.
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Decimal.Value()
+}
+
+// MarshalJSON implements json.Marshaler. It writes null if the decimal is NULL.
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return n.Decimal.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null sets Valid to false.
+func (n *NullDecimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullDecimal{}
+		return nil
+	}
+
+	if err := n.Decimal.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+func (d Decimal) coefficient() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+
+	return d.value
+}
+
+// align returns the coefficients of x and y in the larger scale of them. They are new, so they can be modified.
+func align(x, y Decimal) (*big.Int, *big.Int) {
+	scale := maxInt(x.scale, y.scale)
+	return new(big.Int).Mul(x.coefficient(), pow10(scale-x.scale)), new(big.Int).Mul(y.coefficient(), pow10(scale-y.scale))
+}
+
+// roundQuotient returns numerator / denominator rounded with the mode.
+func roundQuotient(numerator, denominator *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	sign := numerator.Sign() * denominator.Sign()
+	// half compares the remainder with the half of denominator: -1 is below, 0 is halfway and 1 is above.
+	twice := new(big.Int).Abs(remainder)
+	half := twice.Lsh(twice, 1).Cmp(new(big.Int).Abs(denominator))
+
+	var increment bool
+	switch mode {
+	case RoundHalfUp:
+		increment = half >= 0
+	case RoundHalfEven:
+		increment = half > 0 || half == 0 && quotient.Bit(0) == 1
+	case RoundUp:
+		increment = true
+	case RoundCeiling:
+		increment = sign > 0
+	case RoundFloor:
+		increment = sign < 0
+	}
+
+	if increment {
+		quotient.Add(quotient, big.NewInt(int64(sign)))
+	}
+
+	return quotient
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,247 @@
+package conv
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseDecimal_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str           string
+		expected      string
+		expectedScale int
+	}{
+		{str: "0", expected: "0", expectedScale: 0},
+		{str: "12.50", expected: "12.50", expectedScale: 2},
+		{str: "-0.001", expected: "-0.001", expectedScale: 3},
+		{str: "+1", expected: "1", expectedScale: 0},
+		{str: ".5", expected: "0.5", expectedScale: 1},
+		{str: "5.", expected: "5", expectedScale: 0},
+		{str: "1.5e3", expected: "1500", expectedScale: 0},
+		{str: "1.5E-3", expected: "0.0015", expectedScale: 4},
+		{str: "123456789012345678901234567890.123456789", expected: "123456789012345678901234567890.123456789", expectedScale: 9},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseDecimal(table.str)
+
+		// THEN
+		assert.Nil(err, table.str)
+		assert.Equal(table.expected, actual.String(), table.str)
+		assert.Equal(table.expectedScale, actual.Scale(), table.str)
+	}
+}
+
+func TestParseDecimal_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []string{"", "-", ".", "1.2.3", "1,5", "--1", "1e", "e5", "1e99999999", "NaN", " 1"}
+
+	for _, table := range tables {
+		// WHEN
+		_, err := ParseDecimal(table)
+
+		// THEN
+		assert.NotNil(err, table)
+		assert.Equal("Invalid decimal \""+table+"\"", err.Error())
+	}
+	assert.Panics(func() {
+		MustParseDecimal("abc")
+	})
+}
+
+func TestDecimal_Arithmetic_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.2")
+	price := MustParseDecimal("19.99")
+
+	// WHEN
+	sum := a.Add(b)
+	diff := a.Sub(MustParseDecimal("1.25"))
+	product := price.Mul(NewDecimal(3, 0))
+	quotient, err := NewDecimal(10, 0).Div(NewDecimal(3, 0), 2, RoundHalfUp)
+	_, errZero := price.Div(Decimal{}, 2, RoundHalfUp)
+	_, errScale := price.Div(a, -1, RoundHalfUp)
+
+	// THEN
+	assert.Equal("0.3", sum.String())
+	assert.True(sum.Equal(MustParseDecimal("0.30")))
+	assert.Equal("-1.15", diff.String())
+	assert.Equal("59.97", product.String())
+	assert.Nil(err)
+	assert.Equal("3.33", quotient.String())
+	assert.Equal("Division by zero", errZero.Error())
+	assert.Equal("Scale -1 must not be negative", errScale.Error())
+	assert.Equal(-1, a.Cmp(b))
+	assert.Equal(1, price.Cmp(b))
+	assert.Equal("-19.99", price.Neg().String())
+	assert.Equal("19.99", price.Neg().Abs().String())
+	assert.Equal(-1, price.Neg().Sign())
+	assert.True(Decimal{}.IsZero())
+	assert.Equal("0", Decimal{}.String())
+	assert.Equal("12.5", NewDecimal(125, 1).String())
+	assert.Equal("1200", NewDecimal(12, -2).String())
+	assert.Equal(19.99, price.Float64())
+}
+
+func TestDecimal_Round_MultipleMode(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		value    string
+		mode     RoundingMode
+		expected string
+	}{
+		{value: "2.345", mode: RoundHalfUp, expected: "2.35"},
+		{value: "-2.345", mode: RoundHalfUp, expected: "-2.35"},
+		{value: "2.344", mode: RoundHalfUp, expected: "2.34"},
+		{value: "2.345", mode: RoundHalfEven, expected: "2.34"},
+		{value: "2.355", mode: RoundHalfEven, expected: "2.36"},
+		{value: "-2.345", mode: RoundHalfEven, expected: "-2.34"},
+		{value: "2.3451", mode: RoundHalfEven, expected: "2.35"},
+		{value: "2.349", mode: RoundDown, expected: "2.34"},
+		{value: "-2.349", mode: RoundDown, expected: "-2.34"},
+		{value: "2.341", mode: RoundUp, expected: "2.35"},
+		{value: "-2.341", mode: RoundUp, expected: "-2.35"},
+		{value: "-2.349", mode: RoundCeiling, expected: "-2.34"},
+		{value: "2.341", mode: RoundCeiling, expected: "2.35"},
+		{value: "-2.341", mode: RoundFloor, expected: "-2.35"},
+		{value: "2.349", mode: RoundFloor, expected: "2.34"},
+		{value: "2.5", mode: RoundHalfUp, expected: "2.50"},
+		{value: "2", mode: RoundHalfUp, expected: "2.00"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := MustParseDecimal(table.value).Round(2, table.mode)
+
+		// THEN
+		assert.Equal(table.expected, actual.String(), table.value)
+	}
+	assert.Equal("1300", MustParseDecimal("1250").Round(-2, RoundHalfUp).String())
+	assert.Equal("1200", MustParseDecimal("1250").Round(-2, RoundHalfEven).String())
+}
+
+func TestDecimal_JSONAndYAML_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	type invoice struct {
+		Amount Decimal `json:"amount" yaml:"amount"`
+		Tax    Decimal `json:"tax" yaml:"tax"`
+	}
+	var fromJSON, fromYAML invoice
+
+	// WHEN
+	errJSON := json.Unmarshal([]byte(`{"amount": 12345678901234567.89, "tax": "0.10"}`), &fromJSON)
+	errYAML := yaml.Unmarshal([]byte("amount: 12345678901234567.89\ntax: \"0.10\"\n"), &fromYAML)
+	outJSON, errOutJSON := json.Marshal(invoice{Amount: MustParseDecimal("12.50")})
+	errInvalid := json.Unmarshal([]byte(`{"amount": "abc"}`), &fromJSON)
+
+	// THEN
+	assert.Nil(errJSON)
+	assert.Nil(errYAML)
+	assert.Nil(errOutJSON)
+	assert.Equal("12345678901234567.89", fromJSON.Amount.String())
+	assert.Equal("0.10", fromJSON.Tax.String())
+	assert.Equal("12345678901234567.89", fromYAML.Amount.String())
+	assert.Equal("0.10", fromYAML.Tax.String())
+	assert.Equal(`{"amount":"12.50","tax":"0"}`, string(outJSON))
+	assert.Equal("Invalid decimal \"abc\"", errInvalid.Error())
+}
+
+func TestDecimal_ScanAndValue_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	var fromBytes, fromString, fromInt, fromFloat, fromNil, fromBool Decimal
+
+	// WHEN
+	errBytes := fromBytes.Scan([]byte("12.50"))
+	errString := fromString.Scan("-0.001")
+	errInt := fromInt.Scan(int64(42))
+	errFloat := fromFloat.Scan(0.1)
+	errNil := fromNil.Scan(nil)
+	errBool := fromBool.Scan(true)
+	value, errValue := MustParseDecimal("12.50").Value()
+
+	// THEN
+	assert.Nil(errBytes)
+	assert.Nil(errString)
+	assert.Nil(errInt)
+	assert.Nil(errFloat)
+	assert.Nil(errValue)
+	assert.Equal("12.50", fromBytes.String())
+	assert.Equal("-0.001", fromString.String())
+	assert.Equal("42", fromInt.String())
+	assert.Equal("0.1", fromFloat.String())
+	assert.Equal("Cannot scan NULL into Decimal, use NullDecimal for the nullable columns", errNil.Error())
+	assert.Equal("Cannot scan bool into Decimal", errBool.Error())
+	assert.Equal("12.50", value)
+}
+
+func TestNullDecimal_ScanAndValue_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	fromNil := NullDecimal{Decimal: MustParseDecimal("1.5"), Valid: true}
+	var fromBytes, fromBool NullDecimal
+
+	// WHEN
+	errNil := fromNil.Scan(nil)
+	errBytes := fromBytes.Scan([]byte("12.50"))
+	errBool := fromBool.Scan(true)
+	valueNil, errValueNil := fromNil.Value()
+	value, errValue := fromBytes.Value()
+
+	// THEN
+	assert.Nil(errNil)
+	assert.Nil(errBytes)
+	assert.Nil(errValueNil)
+	assert.Nil(errValue)
+	assert.False(fromNil.Valid)
+	assert.True(fromNil.Decimal.IsZero())
+	assert.Nil(valueNil)
+	assert.True(fromBytes.Valid)
+	assert.Equal("12.50", fromBytes.Decimal.String())
+	assert.Equal("12.50", value)
+	assert.False(fromBool.Valid)
+	assert.Equal("Cannot scan bool into Decimal", errBool.Error())
+}
+
+func TestNullDecimal_JSON_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	type order struct {
+		Discount NullDecimal `json:"discount"`
+		Tax      NullDecimal `json:"tax"`
+	}
+	var decoded order
+
+	// WHEN
+	errDecode := json.Unmarshal([]byte(`{"discount": null, "tax": "0.10"}`), &decoded)
+	out, errEncode := json.Marshal(decoded)
+
+	// THEN
+	assert.Nil(errDecode)
+	assert.Nil(errEncode)
+	assert.False(decoded.Discount.Valid)
+	assert.True(decoded.Tax.Valid)
+	assert.Equal(`{"discount":null,"tax":"0.10"}`, string(out))
+}
+
+func BenchmarkDecimalAdd(b *testing.B) {
+	x := MustParseDecimal("12345.67")
+	y := MustParseDecimal("0.125")
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = x.Add(y)
+	}
+}
@@ -1,5 +1,6 @@
 // Package conv provides a string and number converter.
 // It includes the following data types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, bool.
+// The generic Parse, ParseOr, MustParse and Format also accept the named types of them such as "type Port uint16".
 //
 // Example Usage
 //
@@ -0,0 +1,172 @@
+package conv
+
+import (
+	"fmt"
+	"strings"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// EnumValue is a value of enum with its name and the other names accepted when parsing.
+type EnumValue[T comparable] struct {
+	// Name is the canonical name used by Format and listed in the error messages.
+	Name string
+	// Value is the value of name.
+	Value T
+	// Aliases are the other names of value such as "enabled" for "active".
+	Aliases []string
+}
+
+// EnumConfig allows users to configure NewEnum.
+type EnumConfig[T comparable] struct {
+	// Name is the name of enum in the error messages such as "status".
+	Name string
+	// Values are the values of enum in the order of Names.
+	Values []EnumValue[T]
+	// CaseSensitive matches the names exactly, otherwise "ACTIVE" and "Active" are the same as "active".
+	CaseSensitive bool
+}
+
+// Enum maps the strings to the values of T such as the constants of "type Status int".
+// It is safe for concurrent use after it is created.
+//
+// 	var statusEnum = conv.MustNewEnum(conv.EnumConfig[Status]{
+// 		Name: "status",
+// 		Values: []conv.EnumValue[Status]{
+// 			{Name: "active", Value: StatusActive, Aliases: []string{"enabled"}},
+// 			{Name: "inactive", Value: StatusInactive, Aliases: []string{"disabled"}},
+// 		},
+// 	})
+//
+// 	// UnmarshalText allows Status to be loaded by config.Load and conv.Decode.
+// 	func (s *Status) UnmarshalText(text []byte) error {
+// 		return statusEnum.UnmarshalText(text, s)
+// 	}
+type Enum[T comparable] struct {
+	name          string
+	caseSensitive bool
+	names         []string
+	values        []T
+	lookup        map[string]T
+	canonical     map[T]string
+}
+
+// NewEnum returns the enum of the values. It returns an error if a name or an alias is used twice, or a value has no name.
+func NewEnum[T comparable](cfg EnumConfig[T]) (*Enum[T], error) {
+	enum := &Enum[T]{
+		name:          cfg.Name,
+		caseSensitive: cfg.CaseSensitive,
+		lookup:        make(map[string]T),
+		canonical:     make(map[T]string, len(cfg.Values)),
+	}
+
+	for _, value := range cfg.Values {
+		if value.Name == "" {
+			return nil, ero.Newf("Missing name of %s value %v", cfg.Name, value.Value)
+		}
+		if _, ok := enum.canonical[value.Value]; ok {
+			return nil, ero.Newf("Duplicate %s value %v", cfg.Name, value.Value)
+		}
+
+		for _, name := range append([]string{value.Name}, value.Aliases...) {
+			key := enum.key(name)
+			if _, ok := enum.lookup[key]; ok {
+				return nil, ero.Newf("Duplicate %s name %q", cfg.Name, name)
+			}
+			enum.lookup[key] = value.Value
+		}
+
+		enum.canonical[value.Value] = value.Name
+		enum.names = append(enum.names, value.Name)
+		enum.values = append(enum.values, value.Value)
+	}
+
+	return enum, nil
+}
+
+// MustNewEnum is like NewEnum but panics if the config is invalid, which is convenient for the package variables.
+func MustNewEnum[T comparable](cfg EnumConfig[T]) *Enum[T] {
+	enum, err := NewEnum(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	return enum
+}
+
+// Parse returns the value of the name or alias. The leading and trailing white space is ignored.
+// The error lists the valid names such as `Invalid status "deleted", expected one of: active, inactive`.
+func (e *Enum[T]) Parse(str string) (T, error) {
+	if value, ok := e.lookup[e.key(strings.TrimSpace(str))]; ok {
+		return value, nil
+	}
+
+	var zero T
+	return zero, ero.Newf("Invalid %s %q, expected one of: %s", e.name, str, strings.Join(e.names, ", "))
+}
+
+// ParseOr returns the value of the name or alias, or def if it is invalid.
+func (e *Enum[T]) ParseOr(str string, def T) T {
+	value, err := e.Parse(str)
+	if err != nil {
+		return def
+	}
+
+	return value
+}
+
+// Format returns the canonical name of the value and true, or "" and false if the value is not in the enum.
+func (e *Enum[T]) Format(value T) (string, bool) {
+	name, ok := e.canonical[value]
+	return name, ok
+}
+
+// String returns the canonical name of the value, or the value printed by fmt if it is not in the enum.
+func (e *Enum[T]) String(value T) string {
+	if name, ok := e.canonical[value]; ok {
+		return name
+	}
+
+	return fmt.Sprint(value)
+}
+
+// Names returns the canonical names in the order of config.
+func (e *Enum[T]) Names() []string {
+	return append([]string(nil), e.names...)
+}
+
+// Values returns the values in the order of config.
+func (e *Enum[T]) Values() []T {
+	return append([]T(nil), e.values...)
+}
+
+// UnmarshalText parses the text into dst. It helps T implement encoding.TextUnmarshaler,
+// so T can be loaded from YAML and JSON by config.Load and from the maps by Decode.
+func (e *Enum[T]) UnmarshalText(text []byte, dst *T) error {
+	value, err := e.Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+// MarshalText returns the canonical name of the value. It helps T implement encoding.TextMarshaler.
+// It returns an error if the value is not in the enum.
+func (e *Enum[T]) MarshalText(value T) ([]byte, error) {
+	name, ok := e.canonical[value]
+	if !ok {
+		return nil, ero.Newf("Invalid %s value %v", e.name, value)
+	}
+
+	return []byte(name), nil
+}
+
+func (e *Enum[T]) key(name string) string {
+	if e.caseSensitive {
+		return name
+	}
+
+	return strings.ToLower(name)
+}
@@ -0,0 +1,176 @@
+package conv
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type status int
+
+const (
+	statusActive status = iota + 1
+	statusInactive
+	statusBanned
+)
+
+var statusEnum = MustNewEnum(EnumConfig[status]{
+	Name: "status",
+	Values: []EnumValue[status]{
+		{Name: "active", Value: statusActive, Aliases: []string{"enabled", "on"}},
+		{Name: "inactive", Value: statusInactive, Aliases: []string{"disabled", "off"}},
+		{Name: "banned", Value: statusBanned},
+	},
+})
+
+func (s status) MarshalText() ([]byte, error) {
+	return statusEnum.MarshalText(s)
+}
+
+func (s *status) UnmarshalText(text []byte) error {
+	return statusEnum.UnmarshalText(text, s)
+}
+
+func TestEnum_Parse_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str      string
+		expected status
+	}{
+		{str: "active", expected: statusActive},
+		{str: "ACTIVE", expected: statusActive},
+		{str: " Enabled ", expected: statusActive},
+		{str: "off", expected: statusInactive},
+		{str: "Banned", expected: statusBanned},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := statusEnum.Parse(table.str)
+
+		// THEN
+		assert.Nil(err, table.str)
+		assert.Equal(table.expected, actual, table.str)
+	}
+}
+
+func TestEnum_MultipleMethod_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	_, err := statusEnum.Parse("deleted")
+	name, ok := statusEnum.Format(statusInactive)
+	_, okUnknown := statusEnum.Format(status(9))
+	_, errMarshal := statusEnum.MarshalText(status(9))
+
+	// THEN
+	assert.Equal("Invalid status \"deleted\", expected one of: active, inactive, banned", err.Error())
+	assert.Equal(statusInactive, statusEnum.ParseOr("off", statusActive))
+	assert.Equal(statusActive, statusEnum.ParseOr("deleted", statusActive))
+	assert.True(ok)
+	assert.Equal("inactive", name)
+	assert.False(okUnknown)
+	assert.Equal("banned", statusEnum.String(statusBanned))
+	assert.Equal("9", statusEnum.String(status(9)))
+	assert.Equal([]string{"active", "inactive", "banned"}, statusEnum.Names())
+	assert.Equal([]status{statusActive, statusInactive, statusBanned}, statusEnum.Values())
+	assert.Equal("Invalid status value 9", errMarshal.Error())
+}
+
+func TestEnum_CaseSensitive_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	levels := MustNewEnum(EnumConfig[string]{
+		Name:          "level",
+		Values:        []EnumValue[string]{{Name: "A", Value: "a"}, {Name: "a", Value: "lower"}},
+		CaseSensitive: true,
+	})
+
+	// WHEN
+	upper, errUpper := levels.Parse("A")
+	lower, errLower := levels.Parse("a")
+
+	// THEN
+	assert.Nil(errUpper)
+	assert.Nil(errLower)
+	assert.Equal("a", upper)
+	assert.Equal("lower", lower)
+}
+
+func TestNewEnum_InvalidConfig_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		cfg         EnumConfig[int]
+		expectedErr string
+	}{
+		{
+			cfg:         EnumConfig[int]{Name: "level", Values: []EnumValue[int]{{Name: "low", Value: 1}, {Name: "LOW", Value: 2}}},
+			expectedErr: "Duplicate level name \"LOW\"",
+		},
+		{
+			cfg:         EnumConfig[int]{Name: "level", Values: []EnumValue[int]{{Name: "low", Value: 1}, {Name: "high", Value: 2, Aliases: []string{"low"}}}},
+			expectedErr: "Duplicate level name \"low\"",
+		},
+		{
+			cfg:         EnumConfig[int]{Name: "level", Values: []EnumValue[int]{{Name: "low", Value: 1}, {Name: "high", Value: 1}}},
+			expectedErr: "Duplicate level value 1",
+		},
+		{
+			cfg:         EnumConfig[int]{Name: "level", Values: []EnumValue[int]{{Value: 1}}},
+			expectedErr: "Missing name of level value 1",
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		_, err := NewEnum(table.cfg)
+
+		// THEN
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+	assert.Panics(func() {
+		MustNewEnum(tables[0].cfg)
+	})
+}
+
+func TestEnum_ConfigLoading_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	type config struct {
+		Status status `yaml:"status" json:"status" conv:"status"`
+	}
+	var fromYAML, fromJSON, fromMap config
+
+	// WHEN
+	errYAML := yaml.Unmarshal([]byte("status: Enabled\n"), &fromYAML)
+	errJSON := json.Unmarshal([]byte(`{"status": "banned"}`), &fromJSON)
+	errMap := Decode(map[string]string{"status": "off"}, &fromMap)
+	errInvalid := json.Unmarshal([]byte(`{"status": "deleted"}`), &fromJSON)
+	out, errOut := json.Marshal(config{Status: statusInactive})
+
+	// THEN
+	assert.Nil(errYAML)
+	assert.Nil(errJSON)
+	assert.Nil(errMap)
+	assert.Nil(errOut)
+	assert.Equal(statusActive, fromYAML.Status)
+	assert.Equal(statusBanned, fromJSON.Status)
+	assert.Equal(statusInactive, fromMap.Status)
+	assert.Equal("Invalid status \"deleted\", expected one of: active, inactive, banned", errInvalid.Error())
+	assert.Equal(`{"status":"inactive"}`, string(out))
+}
+
+func BenchmarkEnumParse(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = statusEnum.Parse("inactive")
+	}
+}
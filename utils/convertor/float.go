@@ -0,0 +1,149 @@
+package conv
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"unsafe"
+)
+
+// FloatFormat allows users to configure how floats are converted to string.
+type FloatFormat struct {
+	// Verb is the format of strconv.FormatFloat: 'f' (-ddd.dddd), 'e' (-d.dddde±dd), 'E' (-d.ddddE±dd), 'g' or 'G' (e or f, whichever is shorter).
+	// If it is 0, 'f' is used.
+	Verb byte
+	// Precision is the number of digits after the decimal point for 'f', 'e' and 'E', or the number of significant digits for 'g' and 'G'.
+	// If it is 0 or negative, the smallest number of digits necessary to represent the value exactly is used,
+	// so the zero value FloatFormat{} never truncates the value, e.g. 1.25 is "1.25".
+	Precision int
+	// HasPrecision uses Precision even if it is 0, e.g. FloatFormat{Precision: 0, HasPrecision: true} rounds 1.5 to "2".
+	// It is not needed for the positive precisions.
+	HasPrecision bool
+	// TrimZeros removes the trailing zeros after the decimal point, and the decimal point itself if nothing is left, e.g. "1.50" is "1.5" and "2.00" is "2".
+	TrimZeros bool
+	// ThousandsSeparator is inserted between every 3 digits of the integer part, e.g. "," gives "1,234,567.5". It is empty by default.
+	ThousandsSeparator string
+	// DecimalSeparator replaces the decimal point, e.g. "," gives "1234,5". If it is empty, "." is used.
+	DecimalSeparator string
+}
+
+var (
+	// LegacyFloatFormat is the format "1.5E+00" used by Format and ConvertFloat64ToString by default.
+	LegacyFloatFormat = FloatFormat{Verb: 'E', Precision: -1}
+	// PlainFloatFormat is the format "1.5" without exponent, which is suitable for CSV output.
+	PlainFloatFormat = FloatFormat{Verb: 'f', Precision: -1}
+)
+
+var defaultFloatFormat atomic.Value
+
+func init() {
+	defaultFloatFormat.Store(LegacyFloatFormat)
+}
+
+// SetDefaultFloatFormat sets the format used by Format, ConvertFloat32ToString and ConvertFloat64ToString.
+// It is shared by every importer of conv, so it should be called once at startup of the main package,
+// e.g. conv.SetDefaultFloatFormat(conv.PlainFloatFormat). Libraries should pass the format per call instead,
+// e.g. conv.ConvertFloat64ToString(1.5, conv.PlainFloatFormat).
+func SetDefaultFloatFormat(format FloatFormat) {
+	defaultFloatFormat.Store(format)
+}
+
+// DefaultFloatFormat returns the format used by Format, ConvertFloat32ToString and ConvertFloat64ToString.
+func DefaultFloatFormat() FloatFormat {
+	return defaultFloatFormat.Load().(FloatFormat)
+}
+
+// FormatFloat converts the float to string with the format.
+//
+// 	conv.FormatFloat(1234567.891, conv.FloatFormat{Verb: 'f', Precision: 2, ThousandsSeparator: ","}) // "1,234,567.89"
+// 	conv.FormatFloat(1234.5, conv.FloatFormat{Verb: 'f', Precision: 2, ThousandsSeparator: ".", DecimalSeparator: ","}) // "1.234,50"
+func FormatFloat[T Float](value T, format FloatFormat) string {
+	return formatFloat(float64(value), int(unsafe.Sizeof(value))*8, format)
+}
+
+// AppendFloat appends the float formatted with the format to dst and returns the extended buffer.
+// It does not allocate if dst has enough capacity.
+func AppendFloat[T Float](dst []byte, value T, format FloatFormat) []byte {
+	return appendFloat(dst, float64(value), int(unsafe.Sizeof(value))*8, format)
+}
+
+func formatFloat(value float64, bitSize int, format FloatFormat) string {
+	var buf [64]byte
+	return string(appendFloat(buf[:0], value, bitSize, format))
+}
+
+func appendFloat(dst []byte, value float64, bitSize int, format FloatFormat) []byte {
+	verb := format.Verb
+	if verb == 0 {
+		verb = 'f'
+	}
+
+	precision := format.Precision
+	if precision < 0 || precision == 0 && !format.HasPrecision {
+		precision = -1
+	}
+
+	if math.IsNaN(value) || math.IsInf(value, 0) ||
+		!format.TrimZeros && format.ThousandsSeparator == "" && (format.DecimalSeparator == "" || format.DecimalSeparator == ".") {
+		return strconv.AppendFloat(dst, value, verb, precision, bitSize)
+	}
+
+	var buf [64]byte
+	str := strconv.AppendFloat(buf[:0], value, verb, precision, bitSize)
+
+	if str[0] == '-' || str[0] == '+' {
+		dst = append(dst, str[0])
+		str = str[1:]
+	}
+
+	var exponent []byte
+	if i := bytes.IndexAny(str, "eEpP"); i >= 0 {
+		str, exponent = str[:i], str[i:]
+	}
+
+	integer, fraction := str, []byte(nil)
+	if i := bytes.IndexByte(str, '.'); i >= 0 {
+		integer, fraction = str[:i], str[i+1:]
+	}
+
+	if format.TrimZeros {
+		fraction = bytes.TrimRight(fraction, "0")
+	}
+
+	if format.ThousandsSeparator != "" {
+		dst = appendGrouped(dst, integer, format.ThousandsSeparator)
+	} else {
+		dst = append(dst, integer...)
+	}
+
+	if len(fraction) > 0 {
+		if format.DecimalSeparator == "" {
+			dst = append(dst, '.')
+		} else {
+			dst = append(dst, format.DecimalSeparator...)
+		}
+		dst = append(dst, fraction...)
+	}
+
+	return append(dst, exponent...)
+}
+
+// appendGrouped appends the digits with the separator between every 3 digits such as "1,234,567".
+func appendGrouped(dst, digits []byte, separator string) []byte {
+	head := len(digits) % 3
+	if head == 0 {
+		head = 3
+	}
+	if head > len(digits) {
+		head = len(digits)
+	}
+
+	dst = append(dst, digits[:head]...)
+	for i := head; i < len(digits); i += 3 {
+		dst = append(dst, separator...)
+		dst = append(dst, digits[i:i+3]...)
+	}
+
+	return dst
+}
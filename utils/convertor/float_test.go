@@ -0,0 +1,102 @@
+package conv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatFloat_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		value    float64
+		format   FloatFormat
+		expected string
+	}{
+		{value: 1.5, format: FloatFormat{}, expected: "1.5"},
+		{value: 1.5, format: PlainFloatFormat, expected: "1.5"},
+		{value: 1.5, format: LegacyFloatFormat, expected: "1.5E+00"},
+		{value: 1234567.891, format: FloatFormat{Verb: 'f', Precision: 2, ThousandsSeparator: ","}, expected: "1,234,567.89"},
+		{value: 1234.5, format: FloatFormat{Verb: 'f', Precision: 2, ThousandsSeparator: ".", DecimalSeparator: ","}, expected: "1.234,50"},
+		{value: -1234567, format: FloatFormat{Verb: 'f', Precision: 2, TrimZeros: true, ThousandsSeparator: " "}, expected: "-1 234 567"},
+		{value: 123, format: FloatFormat{Verb: 'f', Precision: -1, ThousandsSeparator: ","}, expected: "123"},
+		{value: 100.250, format: FloatFormat{Verb: 'f', Precision: 4, TrimZeros: true}, expected: "100.25"},
+		{value: 0.000123, format: FloatFormat{Verb: 'e', Precision: 3}, expected: "1.230e-04"},
+		{value: 0.000123, format: FloatFormat{Verb: 'e', Precision: 3, TrimZeros: true, DecimalSeparator: ","}, expected: "1,23e-04"},
+		{value: 1234567.0, format: FloatFormat{Verb: 'g', Precision: -1}, expected: "1.234567e+06"},
+		{value: 1234.5, format: FloatFormat{Verb: 'G', Precision: 6, ThousandsSeparator: ","}, expected: "1,234.5"},
+		{value: math.Inf(-1), format: FloatFormat{Verb: 'f', Precision: 2, ThousandsSeparator: ","}, expected: "-Inf"},
+		{value: math.NaN(), format: FloatFormat{TrimZeros: true}, expected: "NaN"},
+		{value: 1.25, format: FloatFormat{}, expected: "1.25"},
+		{value: 1234.125, format: FloatFormat{ThousandsSeparator: ","}, expected: "1,234.125"},
+		{value: 1.5, format: FloatFormat{Precision: 0, HasPrecision: true}, expected: "2"},
+		{value: 1234.5, format: FloatFormat{Verb: 'e', HasPrecision: true}, expected: "1e+03"},
+		{value: 1.25, format: FloatFormat{Precision: -1, HasPrecision: true}, expected: "1.25"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := FormatFloat(table.value, table.format)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func TestFormatFloat_Float32_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	f32 := FormatFloat(float32(0.1), PlainFloatFormat)
+	r := FormatFloat(ratio(0.1), PlainFloatFormat)
+
+	// THEN
+	assert.Equal("0.1", f32)
+	assert.Equal("0.1", r)
+}
+
+func TestConvertFloatToString_WithFormat_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	f64 := ConvertFloat64ToString(1.5, PlainFloatFormat)
+	f32 := ConvertFloat32ToString(0.1, FloatFormat{Precision: 2})
+	legacy := ConvertFloat64ToString(1.5)
+
+	// THEN
+	assert.Equal("1.5", f64)
+	assert.Equal("0.10", f32)
+	assert.Equal("1.5E+00", legacy)
+	assert.Equal(LegacyFloatFormat, DefaultFloatFormat())
+}
+
+func TestSetDefaultFloatFormat_PlainFormat_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	defer SetDefaultFloatFormat(DefaultFloatFormat())
+
+	// WHEN
+	legacy := ConvertFloat64ToString(1.5)
+	SetDefaultFloatFormat(PlainFloatFormat)
+
+	// THEN
+	assert.Equal("1.5E+00", legacy)
+	assert.Equal(PlainFloatFormat, DefaultFloatFormat())
+	assert.Equal("1.5", ConvertFloat64ToString(1.5))
+	assert.Equal("0.1", ConvertFloat32ToString(0.1))
+	assert.Equal("0.25", Format(ratio(0.25)))
+}
+
+func BenchmarkFormatFloat(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	format := FloatFormat{Verb: 'f', Precision: 2, ThousandsSeparator: ","}
+
+	for i := 0; i < b.N; i++ {
+		_ = FormatFloat(1234567.891, format)
+	}
+}
@@ -0,0 +1,188 @@
+package conv
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Signed is the constraint of signed integer types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is the constraint of unsigned integer types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is the constraint of integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is the constraint of floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Value is the constraint of types supported by Parse and Format.
+type Value interface {
+	Integer | Float | ~bool
+}
+
+// Parse converts string to T. The value is checked against the range of T, e.g. "200" is out of range of int8.
+// As strconv, the value out of range returns the max or min value of T with the error.
+//
+// 	i8, err := conv.Parse[int8]("-100")
+// 	f64, err := conv.Parse[float64]("1.5")
+// 	b, err := conv.Parse[bool]("true")
+func Parse[T Value](str string) (T, error) {
+	var value T
+	switch p := any(&value).(type) {
+	case *int:
+		i64, err := strconv.ParseInt(str, 10, 0)
+		*p = int(i64)
+		return value, err
+	case *int8:
+		i64, err := strconv.ParseInt(str, 10, 8)
+		*p = int8(i64)
+		return value, err
+	case *int16:
+		i64, err := strconv.ParseInt(str, 10, 16)
+		*p = int16(i64)
+		return value, err
+	case *int32:
+		i64, err := strconv.ParseInt(str, 10, 32)
+		*p = int32(i64)
+		return value, err
+	case *int64:
+		i64, err := strconv.ParseInt(str, 10, 64)
+		*p = i64
+		return value, err
+	case *uint:
+		ui64, err := strconv.ParseUint(str, 10, 0)
+		*p = uint(ui64)
+		return value, err
+	case *uint8:
+		ui64, err := strconv.ParseUint(str, 10, 8)
+		*p = uint8(ui64)
+		return value, err
+	case *uint16:
+		ui64, err := strconv.ParseUint(str, 10, 16)
+		*p = uint16(ui64)
+		return value, err
+	case *uint32:
+		ui64, err := strconv.ParseUint(str, 10, 32)
+		*p = uint32(ui64)
+		return value, err
+	case *uint64:
+		ui64, err := strconv.ParseUint(str, 10, 64)
+		*p = ui64
+		return value, err
+	case *float32:
+		f64, err := strconv.ParseFloat(str, 32)
+		*p = float32(f64)
+		return value, err
+	case *float64:
+		f64, err := strconv.ParseFloat(str, 64)
+		*p = f64
+		return value, err
+	case *bool:
+		b, err := strconv.ParseBool(str)
+		*p = b
+		return value, err
+	}
+
+	// The named types such as "type Port uint16" are converted by their kind.
+	return value, parseValue(reflect.ValueOf(&value).Elem(), str)
+}
+
+// ParseOr converts string to T. It returns def if the string is invalid or out of range of T.
+func ParseOr[T Value](str string, def T) T {
+	value, err := Parse[T](str)
+	if err != nil {
+		return def
+	}
+
+	return value
+}
+
+// MustParse is like Parse but panics if the string is invalid or out of range of T.
+func MustParse[T Value](str string) T {
+	value, err := Parse[T](str)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Format converts T to string. The integers are in base 10, the floats are in DefaultFloatFormat and the bools are "true" or "false".
+func Format[T Value](value T) string {
+	switch v := any(value).(type) {
+	case int:
+		return strconv.FormatInt(int64(v), 10)
+	case int8:
+		return strconv.FormatInt(int64(v), 10)
+	case int16:
+		return strconv.FormatInt(int64(v), 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return formatFloat(float64(v), 32, DefaultFloatFormat())
+	case float64:
+		return formatFloat(v, 64, DefaultFloatFormat())
+	case bool:
+		return strconv.FormatBool(v)
+	}
+
+	return formatValue(reflect.ValueOf(value))
+}
+
+func parseValue(rv reflect.Value, str string) error {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i64, err := strconv.ParseInt(str, 10, rv.Type().Bits())
+		rv.SetInt(i64)
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		ui64, err := strconv.ParseUint(str, 10, rv.Type().Bits())
+		rv.SetUint(ui64)
+		return err
+	case reflect.Float32, reflect.Float64:
+		f64, err := strconv.ParseFloat(str, rv.Type().Bits())
+		rv.SetFloat(f64)
+		return err
+	case reflect.Bool:
+		b, err := strconv.ParseBool(str)
+		rv.SetBool(b)
+		return err
+	}
+
+	return fmt.Errorf("conv: unsupported type %s", rv.Type())
+}
+
+func formatValue(rv reflect.Value) string {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Bool:
+		var buf [64]byte
+		return string(appendValue(buf[:0], rv))
+	}
+
+	return fmt.Sprint(rv.Interface())
+}
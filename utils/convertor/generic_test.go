@@ -0,0 +1,111 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type port uint16
+
+type ratio float64
+
+type enabled bool
+
+func TestParse_MultipleType_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	i8, errInt8 := Parse[int8]("-128")
+	ui64, errUInt64 := Parse[uint64]("18446744073709551615")
+	f32, errFloat32 := Parse[float32]("1.5")
+	b, errBool := Parse[bool]("T")
+	p, errPort := Parse[port]("8080")
+	r, errRatio := Parse[ratio]("0.25")
+	e, errEnabled := Parse[enabled]("false")
+
+	// THEN
+	assert.Nil(errInt8)
+	assert.Nil(errUInt64)
+	assert.Nil(errFloat32)
+	assert.Nil(errBool)
+	assert.Nil(errPort)
+	assert.Nil(errRatio)
+	assert.Nil(errEnabled)
+	assert.Equal(int8(-128), i8)
+	assert.Equal(uint64(18446744073709551615), ui64)
+	assert.Equal(float32(1.5), f32)
+	assert.True(b)
+	assert.Equal(port(8080), p)
+	assert.Equal(ratio(0.25), r)
+	assert.Equal(enabled(false), e)
+}
+
+func TestParse_OutOfRange_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	i8, errInt8 := Parse[int8]("200")
+	p, errPort := Parse[port]("70000")
+	_, errSyntax := Parse[int]("1a2345")
+	_, errBool := Parse[enabled]("yes")
+
+	// THEN
+	assert.Equal(int8(127), i8)
+	assert.Equal("strconv.ParseInt: parsing \"200\": value out of range", errInt8.Error())
+	assert.Equal(port(65535), p)
+	assert.Equal("strconv.ParseUint: parsing \"70000\": value out of range", errPort.Error())
+	assert.Equal("strconv.ParseInt: parsing \"1a2345\": invalid syntax", errSyntax.Error())
+	assert.Equal("strconv.ParseBool: parsing \"yes\": invalid syntax", errBool.Error())
+}
+
+func TestParseOrAndMustParse_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	// THEN
+	assert.Equal(int16(10), ParseOr[int16]("10", 5))
+	assert.Equal(int16(5), ParseOr[int16]("100000", 5))
+	assert.Equal(port(80), ParseOr("abc", port(80)))
+	assert.Equal(uint32(42), MustParse[uint32]("42"))
+	assert.Panics(func() {
+		MustParse[uint32]("-1")
+	})
+}
+
+func TestFormat_MultipleType_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	// THEN
+	assert.Equal("-128", Format(int8(-128)))
+	assert.Equal("18446744073709551615", Format(uint64(18446744073709551615)))
+	assert.Equal("1.5E+00", Format(float32(1.5)))
+	assert.Equal("1.2345E+02", Format(123.45))
+	assert.Equal("true", Format(true))
+	assert.Equal("8080", Format(port(8080)))
+	assert.Equal("2.5E-01", Format(ratio(0.25)))
+	assert.Equal("false", Format(enabled(false)))
+}
+
+func BenchmarkParseInt(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse[int]("123456")
+	}
+}
+
+func BenchmarkParseNamedType(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse[port]("8080")
+	}
+}
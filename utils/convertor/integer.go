@@ -0,0 +1,194 @@
+package conv
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// IntegerParseOptions allows users to configure ParseInteger.
+type IntegerParseOptions struct {
+	// Base is the base from 2 to 36. The prefix of the base, "0b" for 2, "0o" for 8 or "0x" for 16, is optional.
+	// If it is 0, the base is detected from the prefix "0b", "0o" or "0x", otherwise it is 10.
+	// Unlike strconv, a leading "0" is not octal, e.g. "010" is 10.
+	Base int
+	// AllowUnderscores accepts the underscores between digits such as "1_000_000" or "0xFF_FF".
+	AllowUnderscores bool
+	// TrimSpace removes the leading and trailing white space such as " 0x1F\n".
+	TrimSpace bool
+}
+
+// IntegerFormat allows users to configure FormatInteger.
+type IntegerFormat struct {
+	// Base is the base from 2 to 36. If it is 0, 10 is used.
+	Base int
+	// Prefix adds the prefix of the base, "0b" for 2, "0o" for 8 or "0x" for 16. Other bases have no prefix.
+	Prefix bool
+	// Width pads the digits with leading zeros to at least Width digits. The sign and the prefix are not counted.
+	Width int
+	// Upper uses the upper-case letters for the digits greater than 9 such as "1F".
+	Upper bool
+}
+
+// basePrefix returns the prefix of the base such as "0x" for 16.
+func basePrefix(base int) string {
+	switch base {
+	case 2:
+		return "0b"
+	case 8:
+		return "0o"
+	case 16:
+		return "0x"
+	}
+
+	return ""
+}
+
+// prefixBase returns the base of the prefix of str such as 16 for "0x1F", or 10 if there is no prefix.
+func prefixBase(str string) int {
+	if len(str) > 2 && str[0] == '0' {
+		switch str[1] {
+		case 'b', 'B':
+			return 2
+		case 'o', 'O':
+			return 8
+		case 'x', 'X':
+			return 16
+		}
+	}
+
+	return 10
+}
+
+// ParseInteger converts string to T in the base of the options. The value is checked against the range of T.
+// As Parse, the errors are *strconv.NumError and the value out of range returns the max or min value of T with the error.
+//
+// 	id, err := conv.ParseInteger[uint32]("0x1F", conv.IntegerParseOptions{})
+// 	perm, err := conv.ParseInteger[uint32]("755", conv.IntegerParseOptions{Base: 8})
+// 	n, err := conv.ParseInteger[int64](" 1_000_000 ", conv.IntegerParseOptions{AllowUnderscores: true, TrimSpace: true})
+func ParseInteger[T Integer](str string, opts IntegerParseOptions) (T, error) {
+	var zero T
+	signed := zero-1 < 0
+	bitSize := int(unsafe.Sizeof(zero)) * 8
+	fn := "ParseUint"
+	if signed {
+		fn = "ParseInt"
+	}
+
+	digits, base, ok := splitInteger(str, opts)
+	if !ok {
+		return 0, &strconv.NumError{Func: fn, Num: str, Err: strconv.ErrSyntax}
+	}
+
+	if signed {
+		i64, err := strconv.ParseInt(digits, base, bitSize)
+		return T(i64), withNum(err, str)
+	}
+
+	ui64, err := strconv.ParseUint(strings.TrimPrefix(digits, "+"), base, bitSize)
+	return T(ui64), withNum(err, str)
+}
+
+// FormatInteger converts T to string in the base of the format.
+// It panics if the base is not from 2 to 36 as strconv.FormatInt.
+//
+// 	conv.FormatInteger(31, conv.IntegerFormat{Base: 16, Prefix: true, Width: 4, Upper: true}) // "0x001F"
+// 	conv.FormatInteger(5, conv.IntegerFormat{Base: 2, Width: 8})                              // "00000101"
+func FormatInteger[T Integer](value T, format IntegerFormat) string {
+	var buf [72]byte
+	return string(AppendInteger(buf[:0], value, format))
+}
+
+// AppendInteger appends T formatted with the format to dst and returns the extended buffer.
+// It does not allocate if dst has enough capacity. It panics if the base is not from 2 to 36 as strconv.AppendInt.
+func AppendInteger[T Integer](dst []byte, value T, format IntegerFormat) []byte {
+	base := format.Base
+	if base == 0 {
+		base = 10
+	}
+
+	var buf [65]byte
+	var digits []byte
+	if zero := T(0); zero-1 < 0 {
+		digits = strconv.AppendInt(buf[:0], int64(value), base)
+	} else {
+		digits = strconv.AppendUint(buf[:0], uint64(value), base)
+	}
+
+	if digits[0] == '-' {
+		dst = append(dst, '-')
+		digits = digits[1:]
+	}
+	if format.Prefix {
+		dst = append(dst, basePrefix(base)...)
+	}
+	for i := len(digits); i < format.Width; i++ {
+		dst = append(dst, '0')
+	}
+	if !format.Upper {
+		return append(dst, digits...)
+	}
+
+	for _, digit := range digits {
+		if digit >= 'a' && digit <= 'z' {
+			digit -= 'a' - 'A'
+		}
+		dst = append(dst, digit)
+	}
+
+	return dst
+}
+
+// splitInteger returns the sign and the digits without prefix and underscores, and the base of them.
+func splitInteger(str string, opts IntegerParseOptions) (string, int, bool) {
+	if opts.TrimSpace {
+		str = strings.TrimSpace(str)
+	}
+
+	sign := ""
+	if str != "" && (str[0] == '+' || str[0] == '-') {
+		sign, str = str[:1], str[1:]
+	}
+
+	base := opts.Base
+	if base == 0 {
+		base = prefixBase(str)
+	}
+	if prefix := basePrefix(base); prefix != "" && prefixBase(str) == base {
+		str = str[len(prefix):]
+		// The underscore can separate the prefix and the digits such as "0x_FF".
+		if opts.AllowUnderscores && strings.HasPrefix(str, "_") {
+			str = str[1:]
+		}
+	}
+
+	if strings.Contains(str, "_") {
+		if !opts.AllowUnderscores || !validUnderscores(str) {
+			return "", 0, false
+		}
+		str = strings.ReplaceAll(str, "_", "")
+	}
+
+	return sign + str, base, true
+}
+
+// validUnderscores reports whether every underscore is between two digits.
+func validUnderscores(digits string) bool {
+	if strings.HasPrefix(digits, "_") || strings.HasSuffix(digits, "_") {
+		return false
+	}
+
+	return !strings.Contains(digits, "__")
+}
+
+// withNum replaces the input of *strconv.NumError by the original string,
+// since the prefix and the underscores are removed before parsing.
+func withNum(err error, str string) error {
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		numErr.Num = str
+	}
+
+	return err
+}
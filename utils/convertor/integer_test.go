@@ -0,0 +1,140 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInteger_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str      string
+		opts     IntegerParseOptions
+		expected int64
+	}{
+		{str: "123", opts: IntegerParseOptions{}, expected: 123},
+		{str: "010", opts: IntegerParseOptions{}, expected: 10},
+		{str: "0x1F", opts: IntegerParseOptions{}, expected: 31},
+		{str: "-0X1f", opts: IntegerParseOptions{}, expected: -31},
+		{str: "0o755", opts: IntegerParseOptions{}, expected: 493},
+		{str: "0b1010", opts: IntegerParseOptions{}, expected: 10},
+		{str: "+0b1010", opts: IntegerParseOptions{}, expected: 10},
+		{str: "755", opts: IntegerParseOptions{Base: 8}, expected: 493},
+		{str: "1F", opts: IntegerParseOptions{Base: 16}, expected: 31},
+		{str: "0x1F", opts: IntegerParseOptions{Base: 16}, expected: 31},
+		{str: "0b1", opts: IntegerParseOptions{Base: 16}, expected: 177},
+		{str: "zz", opts: IntegerParseOptions{Base: 36}, expected: 1295},
+		{str: "1_000_000", opts: IntegerParseOptions{AllowUnderscores: true}, expected: 1000000},
+		{str: "0x_FF_FF", opts: IntegerParseOptions{AllowUnderscores: true}, expected: 65535},
+		{str: " \t-42\n", opts: IntegerParseOptions{TrimSpace: true}, expected: -42},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseInteger[int64](table.str, table.opts)
+
+		// THEN
+		assert.Nil(err, table.str)
+		assert.Equal(table.expected, actual, table.str)
+	}
+}
+
+func TestParseInteger_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str         string
+		opts        IntegerParseOptions
+		expectedErr string
+	}{
+		{str: "1_000", opts: IntegerParseOptions{}, expectedErr: "strconv.ParseInt: parsing \"1_000\": invalid syntax"},
+		{str: "_1000", opts: IntegerParseOptions{AllowUnderscores: true}, expectedErr: "strconv.ParseInt: parsing \"_1000\": invalid syntax"},
+		{str: "1__000", opts: IntegerParseOptions{AllowUnderscores: true}, expectedErr: "strconv.ParseInt: parsing \"1__000\": invalid syntax"},
+		{str: "1000_", opts: IntegerParseOptions{AllowUnderscores: true}, expectedErr: "strconv.ParseInt: parsing \"1000_\": invalid syntax"},
+		{str: " 42", opts: IntegerParseOptions{}, expectedErr: "strconv.ParseInt: parsing \" 42\": invalid syntax"},
+		{str: "0x", opts: IntegerParseOptions{}, expectedErr: "strconv.ParseInt: parsing \"0x\": invalid syntax"},
+		{str: "0b102", opts: IntegerParseOptions{}, expectedErr: "strconv.ParseInt: parsing \"0b102\": invalid syntax"},
+		{str: "0x80", opts: IntegerParseOptions{}, expectedErr: "strconv.ParseInt: parsing \"0x80\": value out of range"},
+		{str: "12", opts: IntegerParseOptions{Base: 1}, expectedErr: "strconv.ParseInt: parsing \"12\": invalid base 1"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		_, err := ParseInteger[int8](table.str, table.opts)
+
+		// THEN
+		assert.NotNil(err, table.str)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+}
+
+func TestParseInteger_Unsigned_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	u32, errUInt32 := ParseInteger[uint32]("+0xFFFFFFFF", IntegerParseOptions{})
+	p, errPort := ParseInteger[port]("0x1F90", IntegerParseOptions{})
+	u8, errOverflow := ParseInteger[uint8]("0x100", IntegerParseOptions{})
+	_, errNegative := ParseInteger[uint8]("-0x1", IntegerParseOptions{})
+
+	// THEN
+	assert.Nil(errUInt32)
+	assert.Nil(errPort)
+	assert.Equal(uint32(4294967295), u32)
+	assert.Equal(port(8080), p)
+	assert.Equal(uint8(255), u8)
+	assert.Equal("strconv.ParseUint: parsing \"0x100\": value out of range", errOverflow.Error())
+	assert.Equal("strconv.ParseUint: parsing \"-0x1\": invalid syntax", errNegative.Error())
+}
+
+func TestFormatInteger_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		value    int64
+		format   IntegerFormat
+		expected string
+	}{
+		{value: 31, format: IntegerFormat{}, expected: "31"},
+		{value: 31, format: IntegerFormat{Base: 16}, expected: "1f"},
+		{value: 31, format: IntegerFormat{Base: 16, Prefix: true, Width: 4, Upper: true}, expected: "0x001F"},
+		{value: -31, format: IntegerFormat{Base: 16, Prefix: true, Width: 4}, expected: "-0x001f"},
+		{value: 5, format: IntegerFormat{Base: 2, Width: 8}, expected: "00000101"},
+		{value: 493, format: IntegerFormat{Base: 8, Prefix: true}, expected: "0o755"},
+		{value: 1295, format: IntegerFormat{Base: 36, Prefix: true}, expected: "zz"},
+		{value: 123456, format: IntegerFormat{Width: 3}, expected: "123456"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := FormatInteger(table.value, table.format)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func TestFormatInteger_Unsigned_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	u64 := FormatInteger(uint64(18446744073709551615), IntegerFormat{Base: 16, Prefix: true, Upper: true})
+	p := FormatInteger(port(8080), IntegerFormat{Base: 16, Prefix: true, Width: 8})
+
+	// THEN
+	assert.Equal("0xFFFFFFFFFFFFFFFF", u64)
+	assert.Equal("0x00001f90", p)
+}
+
+func BenchmarkParseIntegerHex(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseInteger[uint64]("0xDEADBEEF", IntegerParseOptions{})
+	}
+}
@@ -0,0 +1,205 @@
+package conv
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// Number is the constraint of integer and floating-point types.
+type Number interface {
+	Integer | Float
+}
+
+type siPrefix struct {
+	symbol     string
+	multiplier float64
+}
+
+// siPrefixes are ordered from the largest multiplier, the symbols are case-sensitive as "m" is milli and "M" is mega.
+var siPrefixes = []siPrefix{
+	{symbol: "E", multiplier: 1e18},
+	{symbol: "P", multiplier: 1e15},
+	{symbol: "T", multiplier: 1e12},
+	{symbol: "G", multiplier: 1e9},
+	{symbol: "M", multiplier: 1e6},
+	{symbol: "k", multiplier: 1e3},
+	{symbol: "", multiplier: 1},
+	{symbol: "m", multiplier: 1e-3},
+	{symbol: "u", multiplier: 1e-6},
+	{symbol: "n", multiplier: 1e-9},
+	{symbol: "p", multiplier: 1e-12},
+}
+
+// siAliases are the other symbols accepted by ParseSI.
+var siAliases = map[string]string{
+	"K": "k",
+	"µ": "u",
+}
+
+// Quantity is a number with SI prefix such as "10k" or "500m" which can be used as a field of YAML or JSON config.
+//
+// 	type Config struct {
+// 		Rate conv.Quantity `yaml:"rate" json:"rate"` // rate: 10k
+// 	}
+type Quantity float64
+
+// String returns the quantity with SI prefix such as "1.5k".
+func (q Quantity) String() string {
+	return FormatSI(float64(q), -1)
+}
+
+// MarshalText implements encoding.TextMarshaler, so the quantity is written as "1.5k" in YAML and JSON.
+func (q Quantity) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, so the quantity can be read from "10k" in YAML and JSON.
+func (q *Quantity) UnmarshalText(text []byte) error {
+	value, err := ParseSI[float64](string(text))
+	if err != nil {
+		return err
+	}
+
+	*q = Quantity(value)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, so the quantity can be read from both "10k" and 10000 in JSON.
+func (q *Quantity) UnmarshalJSON(data []byte) error {
+	text, isString := unquoteJSON(data)
+	if isString {
+		return q.UnmarshalText(text)
+	}
+	if string(text) == "null" {
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return ero.Newf("Invalid quantity %s", text)
+	}
+
+	*q = Quantity(value)
+	return nil
+}
+
+// ParseSI converts the number with SI prefix to T such as "10k" to 10000, "3M" to 3000000 or "500m" to 0.5.
+// The prefixes are p, n, u (or µ), m, k (or K), M, G, T, P and E, with an optional space before them.
+// The integer T requires the result to be an integer in the range of T, e.g. "1.5k" is 1500 but "1.5" is an error.
+func ParseSI[T Number](str string) (T, error) {
+	number, symbol := splitQuantity(str)
+	if canonical, ok := siAliases[symbol]; ok {
+		symbol = canonical
+	}
+
+	for _, prefix := range siPrefixes {
+		if prefix.symbol == symbol {
+			return scaleNumber[T](str, number, prefix.multiplier)
+		}
+	}
+
+	return 0, ero.Newf("Unknown prefix %q in quantity %q", symbol, str)
+}
+
+// FormatSI converts the value to string with the largest SI prefix not greater than it such as "1.5k" or "500m".
+// The precision is the number of digits after the decimal point, the trailing zeros are removed.
+// A negative precision uses the smallest number of digits necessary to represent the value exactly.
+func FormatSI(value float64, precision int) string {
+	format := FloatFormat{Verb: 'f', Precision: precision, TrimZeros: true}
+	abs := math.Abs(value)
+	if abs == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return formatFloat(value, 64, format)
+	}
+
+	prefix := siPrefixes[len(siPrefixes)-1]
+	for _, candidate := range siPrefixes {
+		if abs >= candidate.multiplier {
+			prefix = candidate
+			break
+		}
+	}
+
+	return formatFloat(value/prefix.multiplier, 64, format) + prefix.symbol
+}
+
+// splitQuantity splits the string into the number and the unit such as "1.5" and "GiB" of "1.5 GiB".
+func splitQuantity(str string) (string, string) {
+	str = strings.TrimSpace(str)
+	i := 0
+	for i < len(str) && (str[i] >= '0' && str[i] <= '9' || str[i] == '.' || str[i] == '-' || str[i] == '+') {
+		i++
+	}
+
+	return str[:i], strings.TrimSpace(str[i:])
+}
+
+// scaleNumber multiplies the number by the multiplier. The integer T is computed exactly to avoid the rounding of float64.
+func scaleNumber[T Number](str, number string, multiplier float64) (T, error) {
+	var zero T
+	if isFloatType(zero) {
+		f64, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return 0, ero.Newf("Invalid quantity %q", str)
+		}
+		value := T(f64 * multiplier)
+		if math.IsInf(float64(value), 0) {
+			return 0, ero.Newf("Quantity %q is out of range", str)
+		}
+		return value, nil
+	}
+
+	rat, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return 0, ero.Newf("Invalid quantity %q", str)
+	}
+	scale, _ := new(big.Rat).SetString(strconv.FormatFloat(multiplier, 'g', -1, 64))
+	rat.Mul(rat, scale)
+	if !rat.IsInt() {
+		return 0, ero.Newf("Quantity %q is not an integer", str)
+	}
+
+	value, ok := integerOf[T](rat.Num())
+	if !ok {
+		return 0, ero.Newf("Quantity %q is out of range", str)
+	}
+
+	return value, nil
+}
+
+// integerOf converts the big integer to T if it is in the range of T.
+func integerOf[T Number](i *big.Int) (T, bool) {
+	var zero T
+	if zero-1 < 0 {
+		if !i.IsInt64() || int64(T(i.Int64())) != i.Int64() {
+			return 0, false
+		}
+		return T(i.Int64()), true
+	}
+
+	if !i.IsUint64() || uint64(T(i.Uint64())) != i.Uint64() {
+		return 0, false
+	}
+
+	return T(i.Uint64()), true
+}
+
+// isFloatType reports whether T is a floating-point type, in which 1 / 2 is not truncated to 0.
+func isFloatType[T Number](value T) bool {
+	value = 1
+	return value/2 != 0
+}
+
+// unquoteJSON returns the content of the JSON string and true, or the data itself and false for other JSON values.
+func unquoteJSON(data []byte) ([]byte, bool) {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		if str, err := strconv.Unquote(string(data)); err == nil {
+			return []byte(str), true
+		}
+	}
+
+	return data, false
+}
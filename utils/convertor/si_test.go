@@ -0,0 +1,140 @@
+package conv
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseSI_Integer_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str      string
+		expected int64
+	}{
+		{str: "10", expected: 10},
+		{str: "10k", expected: 10000},
+		{str: "10K", expected: 10000},
+		{str: "3M", expected: 3000000},
+		{str: "1.5G", expected: 1500000000},
+		{str: " -2 T ", expected: -2000000000000},
+		{str: "1000m", expected: 1},
+		{str: "9.223372036854775807E", expected: 9223372036854775807},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseSI[int64](table.str)
+
+		// THEN
+		assert.Nil(err, table.str)
+		assert.Equal(table.expected, actual, table.str)
+	}
+}
+
+func TestParseSI_Float_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	milli, errMilli := ParseSI[float64]("500m")
+	micro, errMicro := ParseSI[float64]("2.5µ")
+	kilo, errKilo := ParseSI[float32]("1.5k")
+	quantity, errQuantity := ParseSI[Quantity]("3M")
+
+	// THEN
+	assert.Nil(errMilli)
+	assert.Nil(errMicro)
+	assert.Nil(errKilo)
+	assert.Nil(errQuantity)
+	assert.Equal(0.5, milli)
+	assert.InDelta(2.5e-6, micro, 1e-18)
+	assert.Equal(float32(1500), kilo)
+	assert.Equal(Quantity(3e6), quantity)
+}
+
+func TestParseSI_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	_, errEmpty := ParseSI[int]("k")
+	_, errPrefix := ParseSI[int]("10x")
+	_, errCase := ParseSI[int]("10mb")
+	_, errFraction := ParseSI[int]("1.5")
+	_, errMilli := ParseSI[int]("1500u")
+	_, errRange := ParseSI[int8]("1k")
+	_, errUnsigned := ParseSI[uint]("-1k")
+	_, errFloat := ParseSI[float32]("1E")
+	_, errFloatSyntax := ParseSI[float64]("1..5k")
+
+	// THEN
+	assert.Equal("Invalid quantity \"k\"", errEmpty.Error())
+	assert.Equal("Unknown prefix \"x\" in quantity \"10x\"", errPrefix.Error())
+	assert.Equal("Unknown prefix \"mb\" in quantity \"10mb\"", errCase.Error())
+	assert.Equal("Quantity \"1.5\" is not an integer", errFraction.Error())
+	assert.Equal("Quantity \"1500u\" is not an integer", errMilli.Error())
+	assert.Equal("Quantity \"1k\" is out of range", errRange.Error())
+	assert.Equal("Quantity \"-1k\" is out of range", errUnsigned.Error())
+	assert.Nil(errFloat)
+	assert.Equal("Invalid quantity \"1..5k\"", errFloatSyntax.Error())
+}
+
+func TestFormatSI_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		value     float64
+		precision int
+		expected  string
+	}{
+		{value: 0, precision: -1, expected: "0"},
+		{value: 999, precision: -1, expected: "999"},
+		{value: 10000, precision: -1, expected: "10k"},
+		{value: 1234567, precision: 2, expected: "1.23M"},
+		{value: 1234567, precision: -1, expected: "1.234567M"},
+		{value: -1500, precision: 1, expected: "-1.5k"},
+		{value: 0.5, precision: -1, expected: "500m"},
+		{value: 2.5e-6, precision: 3, expected: "2.5u"},
+		{value: 3e18, precision: -1, expected: "3E"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := FormatSI(table.value, table.precision)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func TestQuantity_YAMLAndJSON_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	type config struct {
+		Rate  Quantity `yaml:"rate" json:"rate"`
+		Ratio Quantity `yaml:"ratio" json:"ratio"`
+	}
+	var fromYAML, fromJSON config
+
+	// WHEN
+	errYAML := yaml.Unmarshal([]byte("rate: 10k\nratio: 0.25\n"), &fromYAML)
+	errJSON := json.Unmarshal([]byte(`{"rate": "10k", "ratio": 2.5e-1}`), &fromJSON)
+	outYAML, errOutYAML := yaml.Marshal(config{Rate: 10000, Ratio: 0.25})
+	outJSON, errOutJSON := json.Marshal(config{Rate: 10000, Ratio: 0.25})
+	errInvalid := json.Unmarshal([]byte(`{"rate": true}`), &fromJSON)
+
+	// THEN
+	assert.Nil(errYAML)
+	assert.Nil(errJSON)
+	assert.Nil(errOutYAML)
+	assert.Nil(errOutJSON)
+	assert.Equal(config{Rate: 10000, Ratio: 0.25}, fromYAML)
+	assert.Equal(config{Rate: 10000, Ratio: 0.25}, fromJSON)
+	assert.Equal("rate: 10k\nratio: 250m\n", string(outYAML))
+	assert.Equal(`{"rate":"10k","ratio":"250m"}`, string(outJSON))
+	assert.Equal("Invalid quantity true", errInvalid.Error())
+}
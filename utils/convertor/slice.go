@@ -0,0 +1,388 @@
+package conv
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// Element is the constraint of types supported by ParseSlice and ParseMap, which are Value and strings.
+type Element interface {
+	Value | ~string
+}
+
+// EmptyPolicy is how ParseSliceWithOptions and ParseMapWithOptions handle the empty elements such as the middle of "1,,2".
+type EmptyPolicy int
+
+const (
+	// EmptyError returns an error for the empty elements. It is the default.
+	EmptyError EmptyPolicy = iota
+	// EmptySkip ignores the empty elements.
+	EmptySkip
+	// EmptyZero parses the empty elements as the zero value of T.
+	EmptyZero
+)
+
+// SliceOptions allows users to configure ParseSliceWithOptions.
+type SliceOptions struct {
+	// TrimSpace removes the leading and trailing white space of the elements, e.g. "1, 2, 3".
+	TrimSpace bool
+	// Empty is how the empty elements are handled, EmptyError by default.
+	Empty EmptyPolicy
+	// Unique removes the duplicate values and keeps the first one.
+	Unique bool
+	// AllErrors reports every invalid element instead of stopping at the first one.
+	AllErrors bool
+}
+
+// MapOptions allows users to configure ParseMapWithOptions.
+type MapOptions struct {
+	// TrimSpace removes the leading and trailing white space of the pairs, keys and values, e.g. "a = 1, b = 2".
+	TrimSpace bool
+	// Empty is how the empty pairs such as the middle of "a=1,,b=2" and the empty values such as "a=" are handled,
+	// EmptyError by default. EmptyZero skips the empty pairs and parses the empty values as the zero value of V.
+	Empty EmptyPolicy
+	// Overwrite keeps the last value of a duplicate key, otherwise the duplicate key is an error.
+	Overwrite bool
+	// AllErrors reports every invalid pair instead of stopping at the first one.
+	AllErrors bool
+}
+
+// ElementError is the error of an element of ParseSlice, FormatSlice, ParseMap or FormatMap.
+type ElementError struct {
+	// Index is the position of the element, starting from 0. The empty elements are counted.
+	Index int
+	// Token is the raw element such as "abc" of "1,abc,3", or the raw pair such as "a=x" of maps.
+	Token string
+	// Err is the reason such as *strconv.NumError.
+	Err error
+}
+
+// Error implements error.
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("Invalid element %q at index %d: %s", e.Token, e.Index, e.Err.Error())
+}
+
+// Unwrap returns the reason of the error.
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// SliceError is the error of ParseSlice, FormatSlice, ParseMap and FormatMap. It has the first invalid element,
+// or every invalid element if AllErrors is set.
+//
+// 	_, err := conv.ParseSlice[int]("1,abc,3", ",")
+// 	var sliceErr *conv.SliceError
+// 	if errors.As(err, &sliceErr) {
+// 		first := sliceErr.Errors[0] // first.Index is 1 and first.Token is "abc"
+// 	}
+type SliceError struct {
+	Errors []*ElementError
+}
+
+// Error implements error. The errors of the elements are joined by "; ".
+func (e *SliceError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the error of the first invalid element.
+func (e *SliceError) Unwrap() error {
+	return e.Errors[0]
+}
+
+// ParseSlice splits str by sep and converts the elements to T. The white space around the elements is ignored
+// and the empty elements are errors. The empty string is an empty slice.
+//
+// 	ids, err := conv.ParseSlice[int64]("1, 2, 3", ",")
+// 	_, err = conv.ParseSlice[int64]("1,abc,3", ",") // Invalid element "abc" at index 1: strconv.ParseInt: parsing "abc": invalid syntax
+func ParseSlice[T Element](str, sep string) ([]T, error) {
+	return ParseSliceWithOptions[T](str, sep, SliceOptions{TrimSpace: true})
+}
+
+// ParseSliceWithOptions splits str by sep and converts the elements to T with the options.
+// It returns *SliceError with the index and the raw token of the invalid elements.
+func ParseSliceWithOptions[T Element](str, sep string, opts SliceOptions) ([]T, error) {
+	if str == "" {
+		return []T{}, nil
+	}
+
+	tokens := strings.Split(str, sep)
+	values := make([]T, 0, len(tokens))
+	var seen map[T]struct{}
+	if opts.Unique {
+		seen = make(map[T]struct{}, len(tokens))
+	}
+
+	var sliceErr *SliceError
+	for i, token := range tokens {
+		value, skip, err := parseToken[T](token, opts.TrimSpace, opts.Empty)
+		if err != nil {
+			sliceErr = addElementError(sliceErr, i, token, err)
+			if !opts.AllErrors {
+				return nil, sliceErr
+			}
+			continue
+		}
+		if skip {
+			continue
+		}
+
+		if opts.Unique {
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+		}
+		values = append(values, value)
+	}
+
+	if sliceErr != nil {
+		return nil, sliceErr
+	}
+
+	return values, nil
+}
+
+// FormatSlice converts the values to string as Format and joins them by sep.
+// It returns *SliceError if a string value contains sep, which cannot be parsed back by ParseSlice.
+//
+// 	str, err := conv.FormatSlice([]int64{1, 2, 3}, ",") // 1,2,3
+func FormatSlice[T Element](values []T, sep string) (string, error) {
+	tokens := make([]string, 0, len(values))
+	var sliceErr *SliceError
+	for i, value := range values {
+		token := formatElement(value)
+		if sep != "" && strings.Contains(token, sep) {
+			sliceErr = addElementError(sliceErr, i, token, ero.Newf("Element contains separator %q", sep))
+		}
+		tokens = append(tokens, token)
+	}
+
+	if sliceErr != nil {
+		return "", sliceErr
+	}
+
+	return strings.Join(tokens, sep), nil
+}
+
+// ParseMap splits str into the pairs by sep and the pairs into the keys and values by kvSep, then converts them to K and V.
+// The white space around the pairs, keys and values is ignored, and the empty pairs, the empty values and the duplicate keys are errors.
+//
+// 	limits, err := conv.ParseMap[string, int]("read=100, write=10", ",", "=")
+func ParseMap[K, V Element](str, sep, kvSep string) (map[K]V, error) {
+	return ParseMapWithOptions[K, V](str, sep, kvSep, MapOptions{TrimSpace: true})
+}
+
+// ParseMapWithOptions splits str into the pairs by sep and the pairs into the keys and values by kvSep with the options.
+// It returns *SliceError with the index and the raw pair of the invalid pairs.
+func ParseMapWithOptions[K, V Element](str, sep, kvSep string, opts MapOptions) (map[K]V, error) {
+	if str == "" {
+		return map[K]V{}, nil
+	}
+
+	pairs := strings.Split(str, sep)
+	values := make(map[K]V, len(pairs))
+
+	var sliceErr *SliceError
+	for i, pair := range pairs {
+		if isEmptyToken(pair, opts.TrimSpace) {
+			if opts.Empty == EmptyError {
+				sliceErr = addElementError(sliceErr, i, pair, ero.New("Empty pair"))
+				if !opts.AllErrors {
+					return nil, sliceErr
+				}
+			}
+			continue
+		}
+
+		key, value, skip, err := parsePair[K, V](pair, kvSep, opts)
+		if err == nil && !skip {
+			if _, ok := values[key]; ok && !opts.Overwrite {
+				err = ero.Newf("Duplicate key %q", formatElement(key))
+			}
+		}
+		if err != nil {
+			sliceErr = addElementError(sliceErr, i, pair, err)
+			if !opts.AllErrors {
+				return nil, sliceErr
+			}
+			continue
+		}
+		if skip {
+			continue
+		}
+
+		values[key] = value
+	}
+
+	if sliceErr != nil {
+		return nil, sliceErr
+	}
+
+	return values, nil
+}
+
+// FormatMap converts the keys and values to string as Format and joins them by kvSep and sep.
+// The pairs are sorted by the keys, so the result is stable. It returns *SliceError if a key contains sep or kvSep,
+// or a value contains sep, which cannot be parsed back by ParseMap.
+//
+// 	str, err := conv.FormatMap(map[string]int{"write": 10, "read": 100}, ",", "=") // read=100,write=10
+func FormatMap[K, V Element](values map[K]V, sep, kvSep string) (string, error) {
+	keys := make([]string, 0, len(values))
+	formatted := make(map[string]string, len(values))
+	for key, value := range values {
+		str := formatElement(key)
+		keys = append(keys, str)
+		formatted[str] = formatElement(value)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	var sliceErr *SliceError
+	for i, key := range keys {
+		pair := key + kvSep + formatted[key]
+		switch {
+		case sep != "" && strings.Contains(key, sep):
+			sliceErr = addElementError(sliceErr, i, pair, ero.Newf("Key contains separator %q", sep))
+		case kvSep != "" && strings.Contains(key, kvSep):
+			sliceErr = addElementError(sliceErr, i, pair, ero.Newf("Key contains separator %q", kvSep))
+		case sep != "" && strings.Contains(formatted[key], sep):
+			sliceErr = addElementError(sliceErr, i, pair, ero.Newf("Value contains separator %q", sep))
+		}
+		pairs = append(pairs, pair)
+	}
+
+	if sliceErr != nil {
+		return "", sliceErr
+	}
+
+	return strings.Join(pairs, sep), nil
+}
+
+// parsePair converts the pair such as "a=1" to K and V. The pair is skipped if the value is empty and the policy is EmptySkip.
+func parsePair[K, V Element](pair, kvSep string, opts MapOptions) (K, V, bool, error) {
+	var key K
+	var value V
+
+	rawKey, rawValue, ok := strings.Cut(pair, kvSep)
+	if !ok {
+		return key, value, false, ero.Newf("Missing separator %q", kvSep)
+	}
+
+	key, _, err := parseToken[K](rawKey, opts.TrimSpace, EmptyError)
+	if err != nil {
+		return key, value, false, ero.Wrap(err).AddContext("Invalid key")
+	}
+
+	value, skip, err := parseToken[V](rawValue, opts.TrimSpace, opts.Empty)
+	if err != nil {
+		return key, value, false, ero.Wrap(err).AddContext("Invalid value")
+	}
+
+	return key, value, skip, nil
+}
+
+// parseToken converts the token to T. It returns true if the token is empty and should be skipped.
+func parseToken[T Element](token string, trimSpace bool, empty EmptyPolicy) (T, bool, error) {
+	var zero T
+	if trimSpace {
+		token = strings.TrimSpace(token)
+	}
+
+	if token == "" {
+		switch empty {
+		case EmptySkip:
+			return zero, true, nil
+		case EmptyZero:
+			return zero, false, nil
+		default:
+			return zero, false, ero.New("Empty element")
+		}
+	}
+
+	value, err := parseElement[T](token)
+	return value, false, err
+}
+
+// parseElement converts string to T as Parse, or sets it to T if T is a string type.
+func parseElement[T Element](str string) (T, error) {
+	var value T
+	var err error
+	switch p := any(&value).(type) {
+	case *string:
+		*p = str
+	case *int:
+		*p, err = Parse[int](str)
+	case *int8:
+		*p, err = Parse[int8](str)
+	case *int16:
+		*p, err = Parse[int16](str)
+	case *int32:
+		*p, err = Parse[int32](str)
+	case *int64:
+		*p, err = Parse[int64](str)
+	case *uint:
+		*p, err = Parse[uint](str)
+	case *uint8:
+		*p, err = Parse[uint8](str)
+	case *uint16:
+		*p, err = Parse[uint16](str)
+	case *uint32:
+		*p, err = Parse[uint32](str)
+	case *uint64:
+		*p, err = Parse[uint64](str)
+	case *float32:
+		*p, err = Parse[float32](str)
+	case *float64:
+		*p, err = Parse[float64](str)
+	case *bool:
+		*p, err = Parse[bool](str)
+	default:
+		// The named types such as "type Tag string" or "type Port uint16" are converted by their kind.
+		rv := reflect.ValueOf(&value).Elem()
+		if rv.Kind() == reflect.String {
+			rv.SetString(str)
+		} else {
+			err = parseValue(rv, str)
+		}
+	}
+
+	return value, err
+}
+
+// formatElement converts T to string as Format, or returns it if T is a string type.
+func formatElement[T Element](value T) string {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.String {
+		return rv.String()
+	}
+
+	return formatValue(rv)
+}
+
+// isEmptyToken reports whether the token is empty, or only has white space if trimSpace is set.
+func isEmptyToken(token string, trimSpace bool) bool {
+	if trimSpace {
+		token = strings.TrimSpace(token)
+	}
+
+	return token == ""
+}
+
+// addElementError adds the error of the element to err, which is created if it is nil.
+func addElementError(err *SliceError, index int, token string, reason error) *SliceError {
+	if err == nil {
+		err = &SliceError{}
+	}
+	err.Errors = append(err.Errors, &ElementError{Index: index, Token: token, Err: reason})
+
+	return err
+}
@@ -0,0 +1,267 @@
+package conv
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type tag string
+
+func TestParseSlice_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	ids, errIDs := ParseSlice[int64]("1, 2 ,3", ",")
+	ports, errPorts := ParseSlice[port]("80;443", ";")
+	flags, errFlags := ParseSlice[bool]("true|false", "|")
+	tags, errTags := ParseSlice[tag](" go , utils ", ",")
+	empty, errEmpty := ParseSlice[int]("", ",")
+
+	// THEN
+	assert.Nil(errIDs)
+	assert.Nil(errPorts)
+	assert.Nil(errFlags)
+	assert.Nil(errTags)
+	assert.Nil(errEmpty)
+	assert.Equal([]int64{1, 2, 3}, ids)
+	assert.Equal([]port{80, 443}, ports)
+	assert.Equal([]bool{true, false}, flags)
+	assert.Equal([]tag{"go", "utils"}, tags)
+	assert.Equal([]int{}, empty)
+}
+
+func TestParseSliceWithOptions_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str      string
+		opts     SliceOptions
+		expected []int
+	}{
+		{str: "1,,2,", opts: SliceOptions{Empty: EmptySkip}, expected: []int{1, 2}},
+		{str: "1,,2,", opts: SliceOptions{Empty: EmptyZero}, expected: []int{1, 0, 2, 0}},
+		{str: "1, ,2", opts: SliceOptions{TrimSpace: true, Empty: EmptySkip}, expected: []int{1, 2}},
+		{str: "3,1,3,2,1", opts: SliceOptions{Unique: true}, expected: []int{3, 1, 2}},
+		{str: "3, 1,,3 ,1", opts: SliceOptions{TrimSpace: true, Empty: EmptySkip, Unique: true}, expected: []int{3, 1}},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseSliceWithOptions[int](table.str, ",", table.opts)
+
+		// THEN
+		assert.Nil(err, table.str)
+		assert.Equal(table.expected, actual, table.str)
+	}
+}
+
+func TestParseSliceWithOptions_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str         string
+		opts        SliceOptions
+		expectedErr string
+	}{
+		{
+			str:         "1,abc,3,x",
+			opts:        SliceOptions{},
+			expectedErr: "Invalid element \"abc\" at index 1: strconv.ParseUint: parsing \"abc\": invalid syntax",
+		},
+		{
+			str:         "1,abc,3,x",
+			opts:        SliceOptions{AllErrors: true},
+			expectedErr: "Invalid element \"abc\" at index 1: strconv.ParseUint: parsing \"abc\": invalid syntax; Invalid element \"x\" at index 3: strconv.ParseUint: parsing \"x\": invalid syntax",
+		},
+		{
+			str:         "1,,2",
+			opts:        SliceOptions{},
+			expectedErr: "Invalid element \"\" at index 1: Empty element",
+		},
+		{
+			str:         "1, 2",
+			opts:        SliceOptions{},
+			expectedErr: "Invalid element \" 2\" at index 1: strconv.ParseUint: parsing \" 2\": invalid syntax",
+		},
+		{
+			str:         "255,256,-1",
+			opts:        SliceOptions{AllErrors: true},
+			expectedErr: "Invalid element \"256\" at index 1: strconv.ParseUint: parsing \"256\": value out of range; Invalid element \"-1\" at index 2: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseSliceWithOptions[uint8](table.str, ",", table.opts)
+
+		// THEN
+		assert.Nil(actual, table.str)
+		assert.NotNil(err, table.str)
+		assert.Equal(table.expectedErr, err.Error(), table.str)
+	}
+}
+
+func TestParseSlice_ErrorPosition_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	var sliceErr *SliceError
+	var numErr *strconv.NumError
+
+	// WHEN
+	_, err := ParseSliceWithOptions[int]("1,abc,3,,5", ",", SliceOptions{AllErrors: true})
+
+	// THEN
+	assert.True(errors.As(err, &sliceErr))
+	assert.Len(sliceErr.Errors, 2)
+	assert.Equal(1, sliceErr.Errors[0].Index)
+	assert.Equal("abc", sliceErr.Errors[0].Token)
+	assert.Equal(3, sliceErr.Errors[1].Index)
+	assert.Equal("", sliceErr.Errors[1].Token)
+	assert.True(errors.As(err, &numErr))
+	assert.Equal(strconv.ErrSyntax, numErr.Err)
+}
+
+func TestFormatSlice_MultipleCase(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	ids, errIDs := FormatSlice([]int64{1, 2, 3}, ",")
+	ports, errPorts := FormatSlice([]port{80, 443}, ";")
+	tags, errTags := FormatSlice([]tag{"go", "utils"}, ",")
+	empty, errEmpty := FormatSlice([]int{}, ",")
+	_, errSep := FormatSlice([]string{"a", "b,c", "d", "e,f"}, ",")
+
+	// THEN
+	assert.Nil(errIDs)
+	assert.Nil(errPorts)
+	assert.Nil(errTags)
+	assert.Nil(errEmpty)
+	assert.Equal("1,2,3", ids)
+	assert.Equal("80;443", ports)
+	assert.Equal("go,utils", tags)
+	assert.Equal("", empty)
+	assert.Equal("Invalid element \"b,c\" at index 1: Element contains separator \",\"; Invalid element \"e,f\" at index 3: Element contains separator \",\"", errSep.Error())
+}
+
+func TestParseMap_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	limits, errLimits := ParseMap[string, int]("read=100, write = 10", ",", "=")
+	ports, errPorts := ParseMap[tag, port]("http:80;https:443", ";", ":")
+	empty, errEmpty := ParseMap[string, int]("", ",", "=")
+	skipped, errSkipped := ParseMapWithOptions[string, int]("a=1,,b=,c=3,", ",", "=", MapOptions{Empty: EmptySkip})
+	zero, errZero := ParseMapWithOptions[string, int]("a=1,,b=", ",", "=", MapOptions{Empty: EmptyZero})
+	overwritten, errOverwritten := ParseMapWithOptions[string, int]("a=1,a=2", ",", "=", MapOptions{Overwrite: true})
+	urls, errURLs := ParseMap[string, string]("home=https://a.com/?q=1", ",", "=")
+
+	// THEN
+	assert.Nil(errLimits)
+	assert.Nil(errPorts)
+	assert.Nil(errEmpty)
+	assert.Nil(errSkipped)
+	assert.Nil(errZero)
+	assert.Nil(errOverwritten)
+	assert.Nil(errURLs)
+	assert.Equal(map[string]int{"read": 100, "write": 10}, limits)
+	assert.Equal(map[tag]port{"http": 80, "https": 443}, ports)
+	assert.Equal(map[string]int{}, empty)
+	assert.Equal(map[string]int{"a": 1, "c": 3}, skipped)
+	assert.Equal(map[string]int{"a": 1, "b": 0}, zero)
+	assert.Equal(map[string]int{"a": 2}, overwritten)
+	assert.Equal(map[string]string{"home": "https://a.com/?q=1"}, urls)
+}
+
+func TestParseMapWithOptions_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		str         string
+		opts        MapOptions
+		expectedErr string
+	}{
+		{
+			str:         "a=1,b",
+			opts:        MapOptions{},
+			expectedErr: "Invalid element \"b\" at index 1: Missing separator \"=\"",
+		},
+		{
+			str:         "a=1,,b=2",
+			opts:        MapOptions{},
+			expectedErr: "Invalid element \"\" at index 1: Empty pair",
+		},
+		{
+			str:         "a=1,b=",
+			opts:        MapOptions{},
+			expectedErr: "Invalid element \"b=\" at index 1: Invalid value: Empty element",
+		},
+		{
+			str:         "=1",
+			opts:        MapOptions{Empty: EmptySkip},
+			expectedErr: "Invalid element \"=1\" at index 0: Invalid key: Empty element",
+		},
+		{
+			str:         "a=1,a=2",
+			opts:        MapOptions{},
+			expectedErr: "Invalid element \"a=2\" at index 1: Duplicate key \"a\"",
+		},
+		{
+			str:         "a=x,b=2,c",
+			opts:        MapOptions{AllErrors: true},
+			expectedErr: "Invalid element \"a=x\" at index 0: Invalid value: strconv.ParseInt: parsing \"x\": invalid syntax; Invalid element \"c\" at index 2: Missing separator \"=\"",
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseMapWithOptions[string, int](table.str, ",", "=", table.opts)
+
+		// THEN
+		assert.Nil(actual, table.str)
+		assert.NotNil(err, table.str)
+		assert.Equal(table.expectedErr, err.Error(), table.str)
+	}
+}
+
+func TestFormatMap_MultipleCase(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	limits, errLimits := FormatMap(map[string]int{"write": 10, "read": 100}, ",", "=")
+	ports, errPorts := FormatMap(map[tag]port{"https": 443, "http": 80}, ";", ":")
+	_, errKey := FormatMap(map[string]int{"a=b": 1}, ",", "=")
+	_, errValue := FormatMap(map[string]string{"a": "1", "b": "2,3"}, ",", "=")
+
+	// THEN
+	assert.Nil(errLimits)
+	assert.Nil(errPorts)
+	assert.Equal("read=100,write=10", limits)
+	assert.Equal("http:80;https:443", ports)
+	assert.Equal("Invalid element \"a=b=1\" at index 0: Key contains separator \"=\"", errKey.Error())
+	assert.Equal("Invalid element \"b=2,3\" at index 1: Value contains separator \",\"", errValue.Error())
+}
+
+func BenchmarkParseSlice(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseSlice[int64]("1001,1002,1003,1004,1005", ",")
+	}
+}
+
+func BenchmarkParseMap(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseMap[string, int]("read=100,write=10", ",", "=")
+	}
+}
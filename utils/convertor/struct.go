@@ -0,0 +1,395 @@
+package conv
+
+import (
+	"encoding"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phamtai97/go-utils/utils/datetime"
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+const (
+	// tagKey is the key of the struct tag, e.g. `conv:"user_id"`. The name "-" skips the field.
+	tagKey = "conv"
+	// tagSeparator is the separator of the slice elements, e.g. `sep:";"`. The default is ",".
+	tagSeparator = "sep"
+	// tagLayout is the layout of time.Time, e.g. `layout:"2006-01-02"`.
+	tagLayout = "layout"
+
+	defaultSeparator = ","
+)
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+	structFields sync.Map
+)
+
+type structField struct {
+	index     int
+	key       string
+	separator string
+	layout    string
+	inline    bool
+}
+
+// Decode sets the fields of the struct pointed to by dst from the values such as query params, CSV rows or Redis hashes.
+//
+// The key of a field is its name or the name in the tag `conv:"name"`, and `conv:"-"` skips the field.
+// The nested structs use the keys prefixed by their key and ".", e.g. "address.city", and the embedded structs are inlined.
+// The pointers are allocated if the values have the keys of their fields. The slices are split by the tag `sep:";"`, "," by default.
+// time.Time is parsed with the tag `layout:"2006-01-02"` in the local location, or by datetime.ParseAny without the tag.
+// time.Duration is parsed by datetime.ParseHumanDuration and the types implementing encoding.TextUnmarshaler by UnmarshalText.
+// The fields without keys in the values are not changed.
+//
+// 	type Query struct {
+// 		UserID int64     `conv:"user_id"`
+// 		Tags   []string  `conv:"tags" sep:";"`
+// 		From   time.Time `conv:"from" layout:"2006-01-02"`
+// 	}
+//
+// 	var query Query
+// 	err := conv.Decode(map[string]string{"user_id": "1", "tags": "a;b", "from": "2021-09-11"}, &query)
+func Decode(values map[string]string, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ero.Newf("Decode requires a non-nil pointer to struct but got %T", dst)
+	}
+
+	return decodeStruct(values, rv.Elem(), "")
+}
+
+// Encode converts the fields of src, a struct or a pointer to struct, to the values with the same rules as Decode.
+// The nil pointers and the nil slices are skipped, time.Time is formatted in RFC 3339 without the tag `layout`
+// and the numbers are formatted by Format. It returns an error if src is not a struct or a pointer to struct,
+// or MarshalText of a field returns an error.
+func Encode(src interface{}) (map[string]string, error) {
+	rv := reflect.Indirect(reflect.ValueOf(src))
+	values := make(map[string]string)
+	if !rv.IsValid() {
+		return values, nil
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, ero.Newf("Encode requires a struct or a pointer to struct but got %T", src)
+	}
+
+	if err := encodeStruct(values, rv, ""); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func decodeStruct(values map[string]string, rv reflect.Value, prefix string) error {
+	for _, field := range fieldsOf(rv.Type()) {
+		if err := decodeField(values, rv.Field(field.index), prefix, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeField(values map[string]string, fv reflect.Value, prefix string, field structField) error {
+	key := prefix + field.key
+	if fv.Kind() == reflect.Ptr {
+		present := false
+		if isNested(fv.Type().Elem()) {
+			present = hasKeys(values, fv.Type().Elem(), nestedPrefix(prefix, field), nil)
+		} else {
+			_, present = values[key]
+		}
+		if !present {
+			return nil
+		}
+
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		fv = fv.Elem()
+	}
+
+	if isNested(fv.Type()) {
+		return decodeStruct(values, fv, nestedPrefix(prefix, field))
+	}
+
+	value, ok := values[key]
+	if !ok {
+		return nil
+	}
+
+	if fv.Kind() == reflect.Slice && !isScalar(fv.Type()) {
+		if value == "" {
+			fv.Set(reflect.MakeSlice(fv.Type(), 0, 0))
+			return nil
+		}
+
+		parts := strings.Split(value, field.separator)
+		slice := reflect.MakeSlice(fv.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := decodeValue(slice.Index(i), part, field.layout); err != nil {
+				return ero.Wrap(err).AddContextf("Invalid value %q of field \"%s[%d]\"", part, key, i)
+			}
+		}
+		fv.Set(slice)
+		return nil
+	}
+
+	if err := decodeValue(fv, value, field.layout); err != nil {
+		return ero.Wrap(err).AddContextf("Invalid value %q of field %q", value, key)
+	}
+
+	return nil
+}
+
+func decodeValue(fv reflect.Value, value, layout string) error {
+	switch {
+	case fv.Type() == timeType:
+		var t time.Time
+		var err error
+		if layout != "" {
+			t, err = time.ParseInLocation(layout, value, time.Local)
+		} else {
+			t, _, err = datetime.ParseAny(value)
+		}
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(t))
+		return nil
+	case fv.Type() == durationType:
+		d, err := datetime.ParseHumanDuration(value)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(int64(d))
+		return nil
+	case reflect.PtrTo(fv.Type()).Implements(textUnmarshalerType):
+		return fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	case fv.Kind() == reflect.String:
+		fv.SetString(value)
+		return nil
+	case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.Uint8:
+		fv.SetBytes([]byte(value))
+		return nil
+	case isScalar(fv.Type()):
+		return parseValue(fv, value)
+	}
+
+	return ero.Newf("Unsupported type %s", fv.Type())
+}
+
+func encodeStruct(values map[string]string, rv reflect.Value, prefix string) error {
+	for _, field := range fieldsOf(rv.Type()) {
+		if err := encodeField(values, rv.Field(field.index), prefix, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeField(values map[string]string, fv reflect.Value, prefix string, field structField) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return nil
+		}
+		fv = fv.Elem()
+	}
+
+	if isNested(fv.Type()) {
+		return encodeStruct(values, fv, nestedPrefix(prefix, field))
+	}
+
+	key := prefix + field.key
+	if fv.Kind() == reflect.Slice && !isScalar(fv.Type()) {
+		if fv.IsNil() {
+			return nil
+		}
+
+		parts := make([]string, fv.Len())
+		for i := range parts {
+			part, err := encodeValue(fv.Index(i), field.layout)
+			if err != nil {
+				return ero.Wrap(err).AddContextf("Cannot encode field \"%s[%d]\"", key, i)
+			}
+			parts[i] = part
+		}
+		values[key] = strings.Join(parts, field.separator)
+		return nil
+	}
+
+	value, err := encodeValue(fv, field.layout)
+	if err != nil {
+		return ero.Wrap(err).AddContextf("Cannot encode field %q", key)
+	}
+
+	values[key] = value
+	return nil
+}
+
+func encodeValue(fv reflect.Value, layout string) (string, error) {
+	switch {
+	case fv.Type() == timeType:
+		if layout == "" {
+			layout = time.RFC3339Nano
+		}
+		return fv.Interface().(time.Time).Format(layout), nil
+	case fv.Type() == durationType:
+		return time.Duration(fv.Int()).String(), nil
+	case fv.Type().Implements(textMarshalerType):
+		return marshalText(fv.Interface().(encoding.TextMarshaler))
+	case reflect.PtrTo(fv.Type()).Implements(textMarshalerType):
+		// MarshalText has the pointer receiver as UnmarshalText, which is used by Decode.
+		if !fv.CanAddr() {
+			copied := reflect.New(fv.Type()).Elem()
+			copied.Set(fv)
+			fv = copied
+		}
+		return marshalText(fv.Addr().Interface().(encoding.TextMarshaler))
+	case fv.Kind() == reflect.String:
+		return fv.String(), nil
+	case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.Uint8:
+		return string(fv.Bytes()), nil
+	}
+
+	return formatValue(fv), nil
+}
+
+func marshalText(marshaler encoding.TextMarshaler) (string, error) {
+	text, err := marshaler.MarshalText()
+	if err != nil {
+		return "", err
+	}
+
+	return string(text), nil
+}
+
+// fieldsOf returns the exported fields of the struct type with their tags, which are cached by type.
+func fieldsOf(t reflect.Type) []structField {
+	if fields, ok := structFields.Load(t); ok {
+		return fields.([]structField)
+	}
+
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+
+		name := sf.Tag.Get(tagKey)
+		if name == "-" {
+			continue
+		}
+
+		field := structField{
+			index:     i,
+			key:       name,
+			separator: sf.Tag.Get(tagSeparator),
+			layout:    sf.Tag.Get(tagLayout),
+		}
+		if field.separator == "" {
+			field.separator = defaultSeparator
+		}
+
+		fieldType := sf.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		field.inline = sf.Anonymous && name == "" && isNested(fieldType)
+		// The unexported embedded struct is inlined for its exported fields, but its pointer cannot be allocated.
+		if sf.PkgPath != "" && (!field.inline || sf.Type.Kind() == reflect.Ptr) {
+			continue
+		}
+		if field.key == "" {
+			field.key = sf.Name
+		}
+
+		fields = append(fields, field)
+	}
+
+	actual, _ := structFields.LoadOrStore(t, fields)
+	return actual.([]structField)
+}
+
+// isNested reports whether the type is a struct decoded field by field rather than from a single value.
+func isNested(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType && !reflect.PtrTo(t).Implements(textUnmarshalerType)
+}
+
+// isScalar reports whether the type is decoded from a single value without splitting.
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Uint8 || reflect.PtrTo(t).Implements(textUnmarshalerType)
+	}
+
+	return false
+}
+
+func nestedPrefix(prefix string, field structField) string {
+	if field.inline {
+		return prefix
+	}
+
+	return prefix + field.key + "."
+}
+
+// hasKeys reports whether the values have a key of a field of the struct type t with the prefix.
+// The embedded structs are checked recursively since they share the prefix of t, and seen stops the structs
+// embedding themselves through a pointer.
+func hasKeys(values map[string]string, t reflect.Type, prefix string, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	if seen == nil {
+		seen = make(map[reflect.Type]bool)
+	}
+	seen[t] = true
+
+	for _, field := range fieldsOf(t) {
+		ft := t.Field(field.index).Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		switch {
+		case isNested(ft) && field.inline:
+			if hasKeys(values, ft, prefix, seen) {
+				return true
+			}
+		case isNested(ft):
+			if hasPrefix(values, nestedPrefix(prefix, field)) {
+				return true
+			}
+		default:
+			if _, ok := values[prefix+field.key]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func hasPrefix(values map[string]string, prefix string) bool {
+	for key := range values {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,377 @@
+package conv
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	City string `conv:"city"`
+	Zip  uint32 `conv:"zip"`
+}
+
+type audit struct {
+	CreatedAt time.Time `conv:"created_at" layout:"2006-01-02 15:04:05"`
+}
+
+type profile struct {
+	audit
+	UserID   int64         `conv:"user_id"`
+	Name     string        `conv:"name"`
+	Active   bool          `conv:"active"`
+	Score    float64       `conv:"score"`
+	Port     port          `conv:"port"`
+	Tags     []string      `conv:"tags" sep:";"`
+	IDs      []int         `conv:"ids"`
+	Timeout  time.Duration `conv:"timeout"`
+	BirthDay time.Time     `conv:"birthday"`
+	IP       net.IP        `conv:"ip"`
+	Address  address       `conv:"address"`
+	Billing  *address      `conv:"billing"`
+	Nickname *string       `conv:"nickname"`
+	Secret   string        `conv:"-"`
+	Raw      string
+	internal string
+}
+
+// level implements encoding.TextMarshaler and encoding.TextUnmarshaler with the pointer receivers.
+type level int
+
+func (l *level) MarshalText() ([]byte, error) {
+	if *l < 0 {
+		return nil, ero.Newf("Invalid level %d", int(*l))
+	}
+
+	return []byte(strings.Repeat("*", int(*l))), nil
+}
+
+func (l *level) UnmarshalText(text []byte) error {
+	*l = level(len(text))
+	return nil
+}
+
+type rating struct {
+	Level  level   `conv:"level"`
+	Levels []level `conv:"levels"`
+}
+
+// Paging and Meta are exported since reflect cannot allocate the embedded pointers of unexported types.
+type Paging struct {
+	Page int `conv:"page"`
+}
+
+type Meta struct {
+	*Paging
+	Version int `conv:"version"`
+}
+
+type search struct {
+	*Meta
+	Name string `conv:"name"`
+}
+
+func TestDecode_AllFieldTypes_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	values := map[string]string{
+		"created_at":   "2021-09-11 17:55:57",
+		"user_id":      "1001",
+		"name":         "Tai",
+		"active":       "true",
+		"score":        "9.5",
+		"port":         "8080",
+		"tags":         "go;utils",
+		"ids":          "1,2,3",
+		"timeout":      "1m30s",
+		"birthday":     "1997-09-11",
+		"ip":           "10.0.0.1",
+		"address.city": "HCM",
+		"address.zip":  "700000",
+		"billing.city": "HN",
+		"nickname":     "tai",
+		"Secret":       "ignored",
+		"Raw":          "raw",
+		"internal":     "ignored",
+	}
+	var actual profile
+
+	// WHEN
+	err := Decode(values, &actual)
+
+	// THEN
+	assert.Nil(err)
+	assert.Equal(time.Date(2021, 9, 11, 17, 55, 57, 0, time.Local), actual.CreatedAt)
+	assert.Equal(int64(1001), actual.UserID)
+	assert.Equal("Tai", actual.Name)
+	assert.True(actual.Active)
+	assert.Equal(9.5, actual.Score)
+	assert.Equal(port(8080), actual.Port)
+	assert.Equal([]string{"go", "utils"}, actual.Tags)
+	assert.Equal([]int{1, 2, 3}, actual.IDs)
+	assert.Equal(90*time.Second, actual.Timeout)
+	assert.Equal(time.Date(1997, 9, 11, 0, 0, 0, 0, time.Local), actual.BirthDay)
+	assert.Equal("10.0.0.1", actual.IP.String())
+	assert.Equal(address{City: "HCM", Zip: 700000}, actual.Address)
+	assert.Equal(&address{City: "HN"}, actual.Billing)
+	assert.Equal("tai", *actual.Nickname)
+	assert.Empty(actual.Secret)
+	assert.Equal("raw", actual.Raw)
+	assert.Empty(actual.internal)
+}
+
+func TestDecode_MissingKeys_Unchanged(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	actual := profile{Name: "Tai", Tags: []string{"go"}}
+
+	// WHEN
+	err := Decode(map[string]string{"user_id": "1", "tags": ""}, &actual)
+
+	// THEN
+	assert.Nil(err)
+	assert.Equal(int64(1), actual.UserID)
+	assert.Equal("Tai", actual.Name)
+	assert.Equal([]string{}, actual.Tags)
+	assert.Nil(actual.Billing)
+	assert.Nil(actual.Nickname)
+}
+
+func TestDecode_EmbeddedPointer_AllocatedIfKeysPresent(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		values          map[string]string
+		expected        search
+		expectedEncoded map[string]string
+	}{
+		{
+			values:          map[string]string{"name": "z"},
+			expected:        search{Name: "z"},
+			expectedEncoded: map[string]string{"name": "z"},
+		},
+		{
+			values:          map[string]string{"name": "z", "version": "1"},
+			expected:        search{Meta: &Meta{Version: 1}, Name: "z"},
+			expectedEncoded: map[string]string{"name": "z", "version": "1"},
+		},
+		{
+			values:          map[string]string{"page": "2"},
+			expected:        search{Meta: &Meta{Paging: &Paging{Page: 2}}},
+			expectedEncoded: map[string]string{"name": "", "version": "0", "page": "2"},
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		var actual search
+		errDecode := Decode(table.values, &actual)
+		values, errEncode := Encode(actual)
+
+		// THEN
+		assert.Nil(errDecode)
+		assert.Nil(errEncode)
+		assert.Equal(table.expected, actual)
+		assert.Equal(table.expectedEncoded, values)
+	}
+}
+
+func TestDecode_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		values      map[string]string
+		expectedErr string
+	}{
+		{
+			values:      map[string]string{"user_id": "abc"},
+			expectedErr: "Invalid value \"abc\" of field \"user_id\": strconv.ParseInt: parsing \"abc\": invalid syntax",
+		},
+		{
+			values:      map[string]string{"billing.zip": "-1"},
+			expectedErr: "Invalid value \"-1\" of field \"billing.zip\": strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			values:      map[string]string{"ids": "1,x,3"},
+			expectedErr: "Invalid value \"x\" of field \"ids[1]\": strconv.ParseInt: parsing \"x\": invalid syntax",
+		},
+		{
+			values:      map[string]string{"port": "70000"},
+			expectedErr: "Invalid value \"70000\" of field \"port\": strconv.ParseUint: parsing \"70000\": value out of range",
+		},
+		{
+			values:      map[string]string{"timeout": "10 years"},
+			expectedErr: "Invalid value \"10 years\" of field \"timeout\": Unknown unit \"years\" in duration \"10 years\"",
+		},
+		{
+			values:      map[string]string{"birthday": "yesterday"},
+			expectedErr: "Invalid value \"yesterday\" of field \"birthday\": Unknown format of datetime \"yesterday\"",
+		},
+		{
+			values:      map[string]string{"created_at": "x"},
+			expectedErr: "Invalid value \"x\" of field \"created_at\": parsing time \"x\" as \"2006-01-02 15:04:05\": cannot parse \"x\" as \"2006\"",
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		var actual profile
+		err := Decode(table.values, &actual)
+
+		// THEN
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+}
+
+func TestDecode_InvalidDestination_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	var actual profile
+	var unsupported struct {
+		Limits map[string]int `conv:"limits"`
+	}
+
+	// WHEN
+	errValue := Decode(map[string]string{}, actual)
+	errNil := Decode(map[string]string{}, (*profile)(nil))
+	errUnsupported := Decode(map[string]string{"limits": "a=1"}, &unsupported)
+
+	// THEN
+	assert.Equal("Decode requires a non-nil pointer to struct but got conv.profile", errValue.Error())
+	assert.Equal("Decode requires a non-nil pointer to struct but got *conv.profile", errNil.Error())
+	assert.Equal("Invalid value \"a=1\" of field \"limits\": Unsupported type map[string]int", errUnsupported.Error())
+}
+
+func TestEncode_AllFieldTypes_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := time.FixedZone("ICT", 7*3600)
+	input := profile{
+		audit:    audit{CreatedAt: time.Date(2021, 9, 11, 17, 55, 57, 0, hcm)},
+		UserID:   1001,
+		Name:     "Tai",
+		Active:   true,
+		Score:    9.5,
+		Port:     8080,
+		Tags:     []string{"go", "utils"},
+		Timeout:  90 * time.Second,
+		BirthDay: time.Date(1997, 9, 11, 0, 0, 0, 0, hcm),
+		IP:       net.ParseIP("10.0.0.1"),
+		Address:  address{City: "HCM", Zip: 700000},
+		Secret:   "secret",
+	}
+
+	// WHEN
+	actual, err := Encode(&input)
+
+	// THEN
+	assert.Nil(err)
+	assert.Equal(map[string]string{
+		"created_at":   "2021-09-11 17:55:57",
+		"user_id":      "1001",
+		"name":         "Tai",
+		"active":       "true",
+		"score":        "9.5E+00",
+		"port":         "8080",
+		"tags":         "go;utils",
+		"timeout":      "1m30s",
+		"birthday":     "1997-09-11T00:00:00+07:00",
+		"ip":           "10.0.0.1",
+		"address.city": "HCM",
+		"address.zip":  "700000",
+		"Raw":          "",
+	}, actual)
+}
+
+func TestEncodeAndDecode_RoundTrip_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	nickname := "tai"
+	input := profile{
+		audit:    audit{CreatedAt: time.Date(2021, 9, 11, 17, 55, 57, 0, time.Local)},
+		UserID:   1001,
+		IDs:      []int{1, 2, 3},
+		Billing:  &address{City: "HN", Zip: 100000},
+		Nickname: &nickname,
+		Score:    0.1,
+	}
+	var actual profile
+
+	// WHEN
+	values, errEncode := Encode(input)
+	errDecode := Decode(values, &actual)
+
+	// THEN
+	assert.Nil(errEncode)
+	assert.Nil(errDecode)
+	assert.Equal(input, actual)
+}
+
+func TestEncodeAndDecode_PointerReceiverMarshaler_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	input := rating{Level: 3, Levels: []level{1, 2}}
+	var actual rating
+
+	// WHEN
+	values, errEncode := Encode(input)
+	valuesPtr, errEncodePtr := Encode(&input)
+	errDecode := Decode(values, &actual)
+
+	// THEN
+	assert.Nil(errEncode)
+	assert.Nil(errEncodePtr)
+	assert.Nil(errDecode)
+	assert.Equal(map[string]string{"level": "***", "levels": "*,**"}, values)
+	assert.Equal(values, valuesPtr)
+	assert.Equal(input, actual)
+}
+
+func TestEncode_MultipleCase_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		src         interface{}
+		expectedErr string
+	}{
+		{src: 1, expectedErr: "Encode requires a struct or a pointer to struct but got int"},
+		{src: rating{Level: -1}, expectedErr: "Cannot encode field \"level\": Invalid level -1"},
+		{src: &rating{Levels: []level{1, -2}}, expectedErr: "Cannot encode field \"levels[1]\": Invalid level -2"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		values, err := Encode(table.src)
+
+		// THEN
+		assert.Nil(values)
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+
+	values, err := Encode((*profile)(nil))
+	assert.Nil(err)
+	assert.Equal(map[string]string{}, values)
+}
+
+func BenchmarkDecode(b *testing.B) {
+	values := map[string]string{
+		"user_id":      "1001",
+		"name":         "Tai",
+		"tags":         "go;utils",
+		"address.city": "HCM",
+		"address.zip":  "700000",
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var actual profile
+		_ = Decode(values, &actual)
+	}
+}
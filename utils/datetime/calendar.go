@@ -0,0 +1,167 @@
+package datetime
+
+import (
+	"strings"
+	"time"
+
+	"github.com/phamtai97/go-utils/utils/config"
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// CalendarConfig allows users to configure weekends and holidays of business-day calendar.
+//
+// Weekends are the names of weekday such as "Saturday" or "Sat", case-insensitive.
+// If Weekends is empty, Saturday and Sunday are weekends.
+//
+// Holidays are the dates with format YYYY-MM-DD.
+type CalendarConfig struct {
+	Weekends []string `yaml:"weekends" json:"weekends"`
+	Holidays []string `yaml:"holidays" json:"holidays"`
+}
+
+// Calendar knows which days are business days. It is safe for concurrent use.
+//
+// The days are compared by the date of time in its location and moved with the GetAfterTimeOfTimeInLocation semantics,
+// so the results are the start or the end time of day in the location of the input time.
+type Calendar struct {
+	weekends map[time.Weekday]bool
+	holidays map[string]bool
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"sun":       time.Sunday,
+	"monday":    time.Monday,
+	"mon":       time.Monday,
+	"tuesday":   time.Tuesday,
+	"tue":       time.Tuesday,
+	"wednesday": time.Wednesday,
+	"wed":       time.Wednesday,
+	"thursday":  time.Thursday,
+	"thu":       time.Thursday,
+	"friday":    time.Friday,
+	"fri":       time.Friday,
+	"saturday":  time.Saturday,
+	"sat":       time.Saturday,
+}
+
+// NewCalendar returns the *Calendar with config.
+func NewCalendar(cfg CalendarConfig) (*Calendar, error) {
+	calendar := &Calendar{
+		weekends: make(map[time.Weekday]bool),
+		holidays: make(map[string]bool, len(cfg.Holidays)),
+	}
+
+	weekends := cfg.Weekends
+	if len(weekends) == 0 {
+		weekends = []string{"Saturday", "Sunday"}
+	}
+
+	for _, name := range weekends {
+		weekday, ok := weekdayNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, ero.Newf("Invalid weekday %s", name)
+		}
+		calendar.weekends[weekday] = true
+	}
+
+	if len(calendar.weekends) == 7 {
+		return nil, ero.New("Calendar must have at least one business day of week")
+	}
+
+	for _, holiday := range cfg.Holidays {
+		date, err := time.Parse(YYYY_MM_DD, strings.TrimSpace(holiday))
+		if err != nil {
+			return nil, ero.Wrap(err).AddContextf("Invalid holiday %s", holiday)
+		}
+		calendar.holidays[date.Format(YYYY_MM_DD)] = true
+	}
+
+	return calendar, nil
+}
+
+// NewDefaultCalendar returns the *Calendar with Saturday and Sunday as weekends and no holiday.
+func NewDefaultCalendar() *Calendar {
+	calendar, _ := NewCalendar(CalendarConfig{})
+	return calendar
+}
+
+// LoadCalendar loads the calendar config from yaml or json file and returns the *Calendar.
+func LoadCalendar(configPath string) (*Calendar, error) {
+	cfg := CalendarConfig{}
+	if err := config.Load(&cfg, configPath); err != nil {
+		return nil, err
+	}
+
+	return NewCalendar(cfg)
+}
+
+// IsWeekend reports whether the day of t is weekend.
+func (c *Calendar) IsWeekend(t time.Time) bool {
+	return c.weekends[t.Weekday()]
+}
+
+// IsHoliday reports whether the day of t is holiday.
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	return c.holidays[t.Format(YYYY_MM_DD)]
+}
+
+// IsBusinessDay reports whether the day of t is neither weekend nor holiday.
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	return !c.IsWeekend(t) && !c.IsHoliday(t)
+}
+
+// NextBusinessDay returns the start or the end time of the first business day after the day of t, in the location of t.
+func (c *Calendar) NextBusinessDay(t time.Time, isStartTime bool) time.Time {
+	return c.AddBusinessDays(t, 1, isStartTime)
+}
+
+// PreviousBusinessDay returns the start or the end time of the last business day before the day of t, in the location of t.
+func (c *Calendar) PreviousBusinessDay(t time.Time, isStartTime bool) time.Time {
+	return c.AddBusinessDays(t, -1, isStartTime)
+}
+
+// AddBusinessDays returns the start or the end time of the business day that is numberDay business days after the day of t,
+// in the location of t. If numberDay is negative, it goes back. If numberDay is 0, it returns the start or the end time of the day of t.
+func (c *Calendar) AddBusinessDays(t time.Time, numberDay int, isStartTime bool) time.Time {
+	step := 1
+	if numberDay < 0 {
+		step = -1
+		numberDay = -numberDay
+	}
+
+	loc := t.Location()
+	result := GetAfterTimeOfTimeInLocation(t, 0, isStartTime, loc)
+	for numberDay > 0 {
+		result = GetAfterTimeOfTimeInLocation(result, step, isStartTime, loc)
+		if c.IsBusinessDay(result) {
+			numberDay--
+		}
+	}
+
+	return result
+}
+
+// BusinessDaysBetween returns the number of business days from the day of startTime (inclusive) to the day of endTime (exclusive).
+// The days are the dates of startTime and endTime in their own locations, and they are counted in the location of startTime.
+// It is negative if endTime is before startTime.
+func (c *Calendar) BusinessDaysBetween(startTime, endTime time.Time) int {
+	loc := startTime.Location()
+	start := startOfDate(startTime.Year(), startTime.Month(), startTime.Day(), loc)
+	end := startOfDate(endTime.Year(), endTime.Month(), endTime.Day(), loc)
+
+	sign := 1
+	if end.Before(start) {
+		start, end = end, start
+		sign = -1
+	}
+
+	count := 0
+	for day := start; day.Before(end); day = GetAfterTimeOfTimeInLocation(day, 1, true, loc) {
+		if c.IsBusinessDay(day) {
+			count++
+		}
+	}
+
+	return sign * count
+}
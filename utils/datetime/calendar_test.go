@@ -0,0 +1,258 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCalendar(t *testing.T) *Calendar {
+	calendar, err := NewCalendar(CalendarConfig{
+		Weekends: []string{"Saturday", "sun"},
+		Holidays: []string{"2021-09-02", "2021-09-03", "2022-01-01"},
+	})
+	assert.Nil(t, err)
+
+	return calendar
+}
+
+func TestNewCalendar_InvalidConfig_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		cfg         CalendarConfig
+		expectedErr string
+	}{
+		{
+			cfg:         CalendarConfig{Weekends: []string{"Caturday"}},
+			expectedErr: "Invalid weekday Caturday",
+		},
+		{
+			cfg:         CalendarConfig{Weekends: []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}},
+			expectedErr: "Calendar must have at least one business day of week",
+		},
+		{
+			cfg:         CalendarConfig{Holidays: []string{"02-09-2021"}},
+			expectedErr: "Invalid holiday 02-09-2021: parsing time \"02-09-2021\" as \"2006-01-02\": cannot parse \"02-09-2021\" as \"2006\"",
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		calendar, err := NewCalendar(table.cfg)
+
+		// THEN
+		assert.Nil(calendar)
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+}
+
+func TestIsBusinessDay_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	calendar := newTestCalendar(t)
+	tables := []struct {
+		input                 time.Time
+		expectedIsWeekend     bool
+		expectedIsHoliday     bool
+		expectedIsBusinessDay bool
+	}{
+		{input: time.Date(2021, 9, 1, 10, 0, 0, 0, time.Local), expectedIsBusinessDay: true},
+		{input: time.Date(2021, 9, 2, 10, 0, 0, 0, time.Local), expectedIsHoliday: true},
+		{input: time.Date(2021, 9, 4, 10, 0, 0, 0, time.Local), expectedIsWeekend: true},
+		{input: time.Date(2021, 9, 5, 23, 59, 59, 0, time.Local), expectedIsWeekend: true},
+		{input: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local), expectedIsWeekend: true, expectedIsHoliday: true},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		isWeekend := calendar.IsWeekend(table.input)
+		isHoliday := calendar.IsHoliday(table.input)
+		isBusinessDay := calendar.IsBusinessDay(table.input)
+
+		// THEN
+		assert.Equal(table.expectedIsWeekend, isWeekend)
+		assert.Equal(table.expectedIsHoliday, isHoliday)
+		assert.Equal(table.expectedIsBusinessDay, isBusinessDay)
+	}
+}
+
+func TestAddBusinessDays_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	calendar := newTestCalendar(t)
+	tables := []struct {
+		input       time.Time
+		numberDay   int
+		isStartTime bool
+		expected    time.Time
+	}{
+		{
+			// Wednesday before holidays
+			input:       time.Date(2021, 9, 1, 10, 0, 0, 0, time.Local),
+			numberDay:   1,
+			isStartTime: true,
+			expected:    time.Date(2021, 9, 6, 0, 0, 0, 0, time.Local),
+		},
+		{
+			input:       time.Date(2021, 9, 1, 10, 0, 0, 0, time.Local),
+			numberDay:   3,
+			isStartTime: false,
+			expected:    time.Date(2021, 9, 8, 23, 59, 59, 999999999, time.Local),
+		},
+		{
+			input:       time.Date(2021, 9, 6, 10, 0, 0, 0, time.Local),
+			numberDay:   -1,
+			isStartTime: true,
+			expected:    time.Date(2021, 9, 1, 0, 0, 0, 0, time.Local),
+		},
+		{
+			input:       time.Date(2021, 9, 4, 10, 0, 0, 0, time.Local),
+			numberDay:   0,
+			isStartTime: true,
+			expected:    time.Date(2021, 9, 4, 0, 0, 0, 0, time.Local),
+		},
+		{
+			input:       time.Date(2021, 12, 31, 10, 0, 0, 0, time.Local),
+			numberDay:   1,
+			isStartTime: true,
+			expected:    time.Date(2022, 1, 3, 0, 0, 0, 0, time.Local),
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := calendar.AddBusinessDays(table.input, table.numberDay, table.isStartTime)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func TestNextAndPreviousBusinessDay_SimpleInput_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	calendar := newTestCalendar(t)
+	input := time.Date(2021, 9, 2, 10, 0, 0, 0, time.Local)
+
+	// WHEN
+	next := calendar.NextBusinessDay(input, true)
+	previous := calendar.PreviousBusinessDay(input, false)
+
+	// THEN
+	assert.Equal(time.Date(2021, 9, 6, 0, 0, 0, 0, time.Local), next)
+	assert.Equal(time.Date(2021, 9, 1, 23, 59, 59, 999999999, time.Local), previous)
+}
+
+func TestBusinessDaysBetween_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	calendar := newTestCalendar(t)
+	tables := []struct {
+		startTime time.Time
+		endTime   time.Time
+		expected  int
+	}{
+		{
+			startTime: time.Date(2021, 9, 1, 10, 0, 0, 0, time.Local),
+			endTime:   time.Date(2021, 9, 8, 9, 0, 0, 0, time.Local),
+			expected:  3,
+		},
+		{
+			startTime: time.Date(2021, 9, 1, 10, 0, 0, 0, time.Local),
+			endTime:   time.Date(2021, 9, 1, 23, 0, 0, 0, time.Local),
+			expected:  0,
+		},
+		{
+			startTime: time.Date(2021, 9, 8, 10, 0, 0, 0, time.Local),
+			endTime:   time.Date(2021, 9, 1, 10, 0, 0, 0, time.Local),
+			expected:  -3,
+		},
+		{
+			startTime: time.Date(2021, 9, 1, 0, 0, 0, 0, time.Local),
+			endTime:   time.Date(2021, 10, 1, 0, 0, 0, 0, time.Local),
+			expected:  20,
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := calendar.BusinessDaysBetween(table.startTime, table.endTime)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func TestCalendar_NonLocalLocation_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	useFakeClock(t)
+	calendar := newTestCalendar(t)
+	newYork := MustLoadLocation("America/New_York")
+	// Thursday 22:00 in New York is Friday 09:00 in the local timezone Asia/Ho_Chi_Minh.
+	thursday := time.Date(2021, 9, 9, 22, 0, 0, 0, newYork)
+	// Sunday 22:00 in New York is Monday 09:00 in the local timezone.
+	sunday := time.Date(2021, 9, 5, 22, 0, 0, 0, newYork)
+
+	// WHEN
+	next := calendar.NextBusinessDay(thursday, true)
+	previous := calendar.PreviousBusinessDay(thursday, false)
+	after := calendar.AddBusinessDays(thursday, 2, true)
+	between := calendar.BusinessDaysBetween(sunday, sunday.Add(24*time.Hour))
+	betweenWeek := calendar.BusinessDaysBetween(sunday, thursday)
+
+	// THEN
+	assert.Equal(time.Date(2021, 9, 10, 0, 0, 0, 0, newYork), next)
+	assert.Equal(time.Date(2021, 9, 8, 23, 59, 59, 999999999, newYork), previous)
+	assert.Equal(time.Date(2021, 9, 13, 0, 0, 0, 0, newYork), after)
+	assert.Equal(0, between)
+	assert.Equal(3, betweenWeek)
+}
+
+func TestLoadCalendar_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	saturday := time.Date(2021, 9, 4, 10, 0, 0, 0, time.Local)
+
+	// WHEN
+	calendarYaml, errYaml := LoadCalendar("calendar.yaml")
+	calendarJson, errJson := LoadCalendar("calendar.json")
+	_, errNotFound := LoadCalendar("not_found.yaml")
+
+	// THEN
+	assert.Nil(errYaml)
+	assert.Nil(errJson)
+	assert.NotNil(errNotFound)
+	assert.False(calendarYaml.IsBusinessDay(saturday))
+	assert.True(calendarJson.IsBusinessDay(saturday))
+	assert.True(calendarYaml.IsHoliday(time.Date(2021, 9, 2, 10, 0, 0, 0, time.Local)))
+	assert.True(calendarJson.IsHoliday(time.Date(2021, 9, 3, 10, 0, 0, 0, time.Local)))
+}
+
+func TestNewDefaultCalendar_SimpleInput_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	calendar := NewDefaultCalendar()
+
+	// THEN
+	assert.True(calendar.IsBusinessDay(time.Date(2021, 9, 2, 10, 0, 0, 0, time.Local)))
+	assert.False(calendar.IsBusinessDay(time.Date(2021, 9, 4, 10, 0, 0, 0, time.Local)))
+	assert.False(calendar.IsBusinessDay(time.Date(2021, 9, 5, 10, 0, 0, 0, time.Local)))
+}
+
+func BenchmarkBusinessDaysBetween(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	calendar := NewDefaultCalendar()
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local)
+
+	for i := 0; i < b.N; i++ {
+		calendar.BusinessDaysBetween(start, end)
+	}
+}
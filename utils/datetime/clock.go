@@ -0,0 +1,319 @@
+package datetime
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Clock provides the current time and timers so that the time can be controlled in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+	// Sleep pauses the current goroutine for at least the duration d.
+	Sleep(d time.Duration)
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+	// NewTimer creates a new Timer that will send the current time on its channel after at least duration d.
+	NewTimer(d time.Duration) Timer
+	// NewTicker returns a new Ticker that sends the current time on its channel every duration d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is the abstraction of time.Timer.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Ticker is the abstraction of time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+type realClock struct{}
+
+// clockHolder wraps Clock so that the different implementations can be stored in the same atomic.Value.
+type clockHolder struct {
+	clock Clock
+}
+
+var defaultClock atomic.Value
+
+func init() {
+	defaultClock.Store(clockHolder{clock: realClock{}})
+}
+
+// DefaultClock returns the Clock used by the functions without the WithClock suffix such as GetCurrentLocalTime.
+// It is the real clock unless SetDefaultClock has been called.
+func DefaultClock() Clock {
+	return defaultClock.Load().(clockHolder).clock
+}
+
+// SetDefaultClock sets the Clock used by the functions without the WithClock suffix, so they can be faked in tests
+// without changing the call sites. It returns the function restoring the previous clock, and a nil clock restores the real one.
+//
+// 	clock := datetime.NewFakeClock(time.Date(2021, 9, 11, 0, 0, 0, 0, time.Local))
+// 	defer datetime.SetDefaultClock(clock)()
+func SetDefaultClock(clock Clock) func() {
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	previous := defaultClock.Swap(clockHolder{clock: clock}).(clockHolder)
+	return func() {
+		defaultClock.Store(previous)
+	}
+}
+
+// NewRealClock returns the Clock that uses the time package.
+func NewRealClock() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return &realTimer{timer: time.NewTimer(d)}
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{ticker: time.NewTicker(d)}
+}
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t *realTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t *realTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+func (t *realTimer) Reset(d time.Duration) bool {
+	return t.timer.Reset(d)
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t *realTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t *realTicker) Stop() {
+	t.ticker.Stop()
+}
+
+// FakeClock is the Clock that only moves when Advance or Set is called.
+// Timers, tickers, After and Sleep fire when the fake time reaches their deadline.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	clock    *FakeClock
+	deadline time.Time
+	period   time.Duration
+	ch       chan time.Time
+}
+
+// NewFakeClock returns the *FakeClock starting at now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the fake current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Since returns the fake time elapsed since t.
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Sleep blocks until the fake time has been advanced by at least d.
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+// After returns the channel that receives the fake time when it has been advanced by at least d.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// NewTimer returns the Timer that fires when the fake time has been advanced by at least d.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	waiter := &fakeWaiter{
+		clock:    c,
+		deadline: c.now.Add(d),
+		ch:       make(chan time.Time, 1),
+	}
+	c.addWaiter(waiter)
+	c.setLocked(c.now)
+
+	return &fakeTimer{waiter: waiter}
+}
+
+// NewTicker returns the Ticker that fires every time the fake time has been advanced by d.
+// It panics if d <= 0 like time.NewTicker.
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	waiter := &fakeWaiter{
+		clock:    c,
+		deadline: c.now.Add(d),
+		period:   d,
+		ch:       make(chan time.Time, 1),
+	}
+	c.addWaiter(waiter)
+
+	return &fakeTicker{waiter: waiter}
+}
+
+// Advance moves the fake time forward by d and fires the timers and tickers that are due.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setLocked(c.now.Add(d))
+}
+
+// Set moves the fake time to t and fires the timers and tickers that are due.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setLocked(t)
+}
+
+// Waiters returns the number of active timers and tickers.
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.waiters)
+}
+
+func (c *FakeClock) setLocked(t time.Time) {
+	c.now = t
+
+	for len(c.waiters) > 0 && !c.waiters[0].deadline.After(t) {
+		waiter := c.waiters[0]
+		c.waiters = c.waiters[1:]
+
+		// Drop the tick if the previous one has not been received like time.Ticker.
+		select {
+		case waiter.ch <- waiter.deadline:
+		default:
+		}
+
+		if waiter.period > 0 {
+			// Skip the ticks that have been dropped.
+			missed := t.Sub(waiter.deadline)/waiter.period + 1
+			waiter.deadline = waiter.deadline.Add(missed * waiter.period)
+			c.addWaiter(waiter)
+		}
+	}
+}
+
+func (c *FakeClock) addWaiter(waiter *fakeWaiter) {
+	c.waiters = append(c.waiters, waiter)
+	sort.SliceStable(c.waiters, func(i, j int) bool {
+		return c.waiters[i].deadline.Before(c.waiters[j].deadline)
+	})
+}
+
+func (c *FakeClock) removeWaiter(waiter *fakeWaiter) bool {
+	for i, w := range c.waiters {
+		if w == waiter {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+type fakeTimer struct {
+	waiter *fakeWaiter
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.waiter.ch
+}
+
+func (t *fakeTimer) Stop() bool {
+	clock := t.waiter.clock
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	return clock.removeWaiter(t.waiter)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	clock := t.waiter.clock
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	active := clock.removeWaiter(t.waiter)
+	t.waiter.deadline = clock.now.Add(d)
+	clock.addWaiter(t.waiter)
+	clock.setLocked(clock.now)
+
+	return active
+}
+
+type fakeTicker struct {
+	waiter *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.waiter.ch
+}
+
+func (t *fakeTicker) Stop() {
+	clock := t.waiter.clock
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	clock.removeWaiter(t.waiter)
+}
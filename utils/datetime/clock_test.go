@@ -0,0 +1,218 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeClock_AdvanceAndSet_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	start := time.Date(2021, 9, 11, 17, 55, 57, 780000000, time.Local)
+	clock := NewFakeClock(start)
+
+	// WHEN
+	clock.Advance(time.Hour)
+	afterAdvance := clock.Now()
+	clock.Set(start)
+	afterSet := clock.Now()
+
+	// THEN
+	assert.Equal(start.Add(time.Hour), afterAdvance)
+	assert.Equal(start, afterSet)
+	assert.Equal(30*time.Minute, clock.Since(start.Add(-30*time.Minute)))
+}
+
+func TestFakeClock_Timer_FireOnAdvance(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	start := time.Date(2021, 9, 11, 0, 0, 0, 0, time.Local)
+	clock := NewFakeClock(start)
+	timer := clock.NewTimer(time.Minute)
+	after := clock.After(2 * time.Minute)
+
+	// WHEN
+	clock.Advance(59 * time.Second)
+
+	// THEN
+	assert.Len(timer.C(), 0)
+	assert.Equal(2, clock.Waiters())
+
+	clock.Advance(time.Second)
+	assert.Equal(start.Add(time.Minute), <-timer.C())
+	assert.Len(after, 0)
+
+	clock.Set(start.Add(time.Hour))
+	assert.Equal(start.Add(2*time.Minute), <-after)
+	assert.Equal(0, clock.Waiters())
+}
+
+func TestFakeClock_TimerStopAndReset_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	start := time.Date(2021, 9, 11, 0, 0, 0, 0, time.Local)
+	clock := NewFakeClock(start)
+	timer := clock.NewTimer(time.Minute)
+
+	// WHEN
+	stopped := timer.Stop()
+	clock.Advance(time.Minute)
+	stoppedAgain := timer.Stop()
+	reset := timer.Reset(time.Second)
+	clock.Advance(time.Second)
+
+	// THEN
+	assert.True(stopped)
+	assert.False(stoppedAgain)
+	assert.False(reset)
+	assert.Equal(start.Add(time.Minute+time.Second), <-timer.C())
+}
+
+func TestFakeClock_TimerNonPositiveDuration_FireImmediately(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	start := time.Date(2021, 9, 11, 0, 0, 0, 0, time.Local)
+	clock := NewFakeClock(start)
+
+	// WHEN
+	timer := clock.NewTimer(0)
+
+	// THEN
+	assert.Equal(start, <-timer.C())
+}
+
+func TestFakeClock_Ticker_FireOnAdvance(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	start := time.Date(2021, 9, 11, 0, 0, 0, 0, time.Local)
+	clock := NewFakeClock(start)
+	ticker := clock.NewTicker(time.Second)
+
+	// WHEN
+	clock.Advance(time.Second)
+	firstTick := <-ticker.C()
+	clock.Advance(time.Second)
+	secondTick := <-ticker.C()
+	clock.Advance(time.Hour)
+	droppedTick := <-ticker.C()
+	ticker.Stop()
+	clock.Advance(time.Hour)
+
+	// THEN
+	assert.Equal(start.Add(time.Second), firstTick)
+	assert.Equal(start.Add(2*time.Second), secondTick)
+	assert.Equal(start.Add(3*time.Second), droppedTick)
+	assert.Len(ticker.C(), 0)
+	assert.Equal(0, clock.Waiters())
+	assert.Panics(func() {
+		clock.NewTicker(0)
+	})
+}
+
+func TestFakeClock_Sleep_WakeUpOnAdvance(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	clock := NewFakeClock(time.Date(2021, 9, 11, 0, 0, 0, 0, time.Local))
+	done := make(chan struct{})
+
+	// WHEN
+	go func() {
+		clock.Sleep(time.Minute)
+		close(done)
+	}()
+
+	for clock.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(time.Minute)
+
+	// THEN
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail("Sleep has not been woken up")
+	}
+}
+
+func TestRealClock_SimpleInput_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	clock := NewRealClock()
+
+	// WHEN
+	now := clock.Now()
+	timer := clock.NewTimer(time.Millisecond)
+	ticker := clock.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+
+	// THEN
+	assert.WithinDuration(time.Now(), now, time.Second)
+	assert.True((<-timer.C()).After(now))
+	assert.True((<-ticker.C()).After(now))
+	assert.True((<-clock.After(time.Millisecond)).After(now))
+	assert.True(clock.Since(now) > 0)
+}
+
+func TestGetWithClock_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	clock := NewFakeClock(time.Date(2021, 2, 14, 17, 55, 57, 780000000, time.Local))
+
+	// WHEN
+	// THEN
+	assert.Equal(time.Date(2021, 2, 14, 17, 55, 57, 780000000, time.Local), GetCurrentLocalTimeWithClock(clock))
+	assert.Equal(ConvertLocalTimeToMilliseconds(clock.Now()), GetCurrentMilisecondsWithClock(clock))
+	assert.Equal("14-02-2021 17:55:57.780", ConvertCurrentLocalTimeToStringWithClock(clock, DD_MM_YYYY_HH_MM_SS_SSS))
+	assert.Equal(2021, GetYearWithClock(clock))
+	assert.Equal(45, GetDayOfYearWithClock(clock))
+	assert.Equal(14, GetDayOfMonthWithClock(clock))
+	assert.Equal(2, GetMonthOfYearWithClock(clock))
+	assert.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), GetStartLocalTimeOfYearWithClock(clock))
+	assert.Equal(time.Date(2021, 12, 31, 23, 59, 59, 999999999, time.Local), GetEndLocalTimeOfYearWithClock(clock))
+	assert.Equal(time.Date(2021, 2, 1, 0, 0, 0, 0, time.Local), GetStartLocalTimeOfMonthWithClock(clock))
+	assert.Equal(time.Date(2021, 2, 28, 23, 59, 59, 999999999, time.Local), GetEndLocalTimeOfMonthWithClock(clock))
+	assert.Equal(time.Date(2021, 2, 14, 0, 0, 0, 0, time.Local), GetStartLocalTimeOfDayWithClock(clock))
+	assert.Equal(time.Date(2021, 2, 14, 23, 59, 59, 999999999, time.Local), GetEndLocalTimeOfDayWithClock(clock))
+
+	clock.Set(time.Date(2020, 12, 31, 23, 59, 59, 0, time.Local))
+	assert.Equal(366, GetDayOfYearWithClock(clock))
+	assert.Equal(time.Date(2020, 12, 31, 23, 59, 59, 999999999, time.Local), GetEndLocalTimeOfMonthWithClock(clock))
+}
+
+func TestSetDefaultClock_FakeClock_Restore(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	clock := NewFakeClock(time.Date(2021, 2, 14, 17, 55, 57, 780000000, time.Local))
+
+	// WHEN
+	restore := SetDefaultClock(clock)
+	faked := GetCurrentLocalTime()
+	endOfMonth := GetEndLocalTimeOfMonth()
+	restoreReal := SetDefaultClock(nil)
+	_, isReal := DefaultClock().(realClock)
+	restoreReal()
+	fakedAgain := DefaultClock()
+	restore()
+
+	// THEN
+	assert.Equal(time.Date(2021, 2, 14, 17, 55, 57, 780000000, time.Local), faked)
+	assert.Equal(time.Date(2021, 2, 28, 23, 59, 59, 999999999, time.Local), endOfMonth)
+	assert.True(isReal)
+	assert.Equal(clock, fakedAgain)
+	assert.Equal(realClock{}, DefaultClock())
+}
+
+func BenchmarkFakeClockAdvance(b *testing.B) {
+	clock := NewFakeClock(time.Date(2021, 9, 11, 0, 0, 0, 0, time.Local))
+	ticker := clock.NewTicker(time.Second)
+	defer ticker.Stop()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		clock.Advance(time.Second)
+		<-ticker.C()
+	}
+}
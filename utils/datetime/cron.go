@@ -0,0 +1,468 @@
+package datetime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// cronSearchYears is how far Next and Prev search before giving up.
+// It covers the 8 years between two February 29th around a non-leap century year.
+const cronSearchYears = 8
+
+// CronSchedule is a parsed cron expression. It is safe for concurrent use.
+//
+// The supported syntax is:
+// 	second minute hour day-of-month month day-of-week   (6 fields)
+// 	minute hour day-of-month month day-of-week          (5 fields, second is 0)
+//
+// Each field accepts "*", values, ranges "1-5", steps "*/15" or "10-50/10" and lists "1,15,30".
+// Month accepts the names JAN-DEC, day-of-week accepts the names SUN-SAT and both 0 and 7 are Sunday.
+// "?" is the same as "*" in day-of-month and day-of-week.
+// When both day-of-month and day-of-week are restricted, a day matches if either of them matches.
+//
+// The descriptors @yearly (@annually), @monthly, @weekly, @daily (@midnight), @hourly and @every <duration> are supported.
+//
+// The expression may start with "CRON_TZ=<zone>" or "TZ=<zone>" to be evaluated in the IANA zone,
+// otherwise it is evaluated in the location of the given time.
+// The times which do not exist because of DST run once at the end of the gap like Vixie cron, e.g. "30 2 * * *" in America/New_York
+// runs at 03:00 EDT on the day of spring forward, and the repeated times run once at their first occurrence.
+type CronSchedule struct {
+	spec     string
+	second   uint64
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	domStar  bool
+	dowStar  bool
+	every    time.Duration
+	location *time.Location
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronSecond = cronField{name: "second", min: 0, max: 59}
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day-of-month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Day-of-week accepts 7 as Sunday, it is folded to 0 after parsing.
+	cronDow = cronField{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// ParseCron parses the cron expression.
+func ParseCron(spec string) (*CronSchedule, error) {
+	return ParseCronInLocation(spec, nil)
+}
+
+// ParseCronInLocation parses the cron expression which is evaluated in the location.
+// If loc is nil, the expression is evaluated in the location of the given time.
+// A "CRON_TZ=" or "TZ=" prefix in the expression takes precedence over loc.
+func ParseCronInLocation(spec string, loc *time.Location) (*CronSchedule, error) {
+	schedule, err := parseCron(spec, loc)
+	if err != nil {
+		return nil, ero.Wrap(err).AddContextf("Invalid cron expression %q", spec)
+	}
+
+	return schedule, nil
+}
+
+// MustParseCron is like ParseCron but panics if the expression is invalid.
+func MustParseCron(spec string) *CronSchedule {
+	schedule, err := ParseCron(spec)
+	if err != nil {
+		panic(err)
+	}
+
+	return schedule
+}
+
+// String returns the cron expression.
+func (c *CronSchedule) String() string {
+	return c.spec
+}
+
+// Next returns the first time matching the schedule strictly after t, in the location of t.
+// It returns the zero time if nothing matches in the next 8 years.
+//
+// The times skipped by DST are not dropped: they run at the first instant after the gap, and the times in the same gap
+// run only once. The times repeated by DST run at their first occurrence only.
+func (c *CronSchedule) Next(t time.Time) time.Time {
+	if c.every > 0 {
+		return t.Add(c.every)
+	}
+
+	loc := c.locationOf(t)
+	start := t.In(loc).Truncate(time.Second).Add(time.Second)
+	year, month, day := start.Date()
+	for first := true; year <= start.Year()+cronSearchYears; first = false {
+		if c.month&(1<<uint(month)) == 0 {
+			year, month, day = addDate(year, month+1, 1)
+			continue
+		}
+
+		if c.matchDay(year, month, day) {
+			if next, ok := c.nextOfDay(year, month, day, loc, start, first); ok {
+				return next.In(t.Location())
+			}
+		}
+		year, month, day = addDate(year, month, day+1)
+	}
+
+	return time.Time{}
+}
+
+// NextN returns at most n times matching the schedule after t, in ascending order.
+// It returns less than n times if the schedule stops matching.
+func (c *CronSchedule) NextN(t time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	for len(times) < n {
+		t = c.Next(t)
+		if t.IsZero() {
+			break
+		}
+		times = append(times, t)
+	}
+
+	return times
+}
+
+// Prev returns the last time matching the schedule strictly before t, in the location of t.
+// It returns the zero time if nothing matches in the previous 8 years.
+func (c *CronSchedule) Prev(t time.Time) time.Time {
+	if c.every > 0 {
+		return t.Add(-c.every)
+	}
+
+	loc := c.locationOf(t)
+	end := t.In(loc)
+	if truncated := end.Truncate(time.Second); truncated.Equal(end) {
+		end = end.Add(-time.Second)
+	} else {
+		end = truncated
+	}
+
+	year, month, day := end.Date()
+	for first := true; year >= end.Year()-cronSearchYears; first = false {
+		if c.month&(1<<uint(month)) == 0 {
+			// The day 0 is the last day of previous month.
+			year, month, day = addDate(year, month, 0)
+			continue
+		}
+
+		if c.matchDay(year, month, day) {
+			if prev, ok := c.prevOfDay(year, month, day, loc, end, first); ok {
+				return prev.In(t.Location())
+			}
+		}
+		year, month, day = addDate(year, month, day-1)
+	}
+
+	return time.Time{}
+}
+
+func (c *CronSchedule) locationOf(t time.Time) *time.Location {
+	if c.location != nil {
+		return c.location
+	}
+
+	return t.Location()
+}
+
+func (c *CronSchedule) matchDay(year int, month time.Month, day int) bool {
+	weekday := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()
+	domMatch := c.dom&(1<<uint(day)) != 0
+	dowMatch := c.dow&(1<<uint(weekday)) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+// nextOfDay returns the first time of the day matching the schedule that is not before start.
+// If first is true, the day is the day of start and the hours and minutes ending before start are skipped.
+// They are compared as instants, not as the wall clocks of start, so a time in a DST gap is kept
+// when start is after the gap.
+func (c *CronSchedule) nextOfDay(year int, month time.Month, day int, loc *time.Location, start time.Time, first bool) (time.Time, bool) {
+	for hour := 0; hour <= 23; hour++ {
+		if c.hour&(1<<uint(hour)) == 0 || first && wallClock(year, month, day, hour, 59, 59, loc).Before(start) {
+			continue
+		}
+		for minute := 0; minute <= 59; minute++ {
+			if c.minute&(1<<uint(minute)) == 0 || first && wallClock(year, month, day, hour, minute, 59, loc).Before(start) {
+				continue
+			}
+			for second := 0; second <= 59; second++ {
+				if c.second&(1<<uint(second)) == 0 {
+					continue
+				}
+				if t := wallClock(year, month, day, hour, minute, second, loc); !t.Before(start) {
+					return t, true
+				}
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// prevOfDay returns the last time of the day matching the schedule that is not after end.
+// If first is true, the day is the day of end and the hours and minutes starting after end are skipped.
+// As nextOfDay, they are compared as instants.
+func (c *CronSchedule) prevOfDay(year int, month time.Month, day int, loc *time.Location, end time.Time, first bool) (time.Time, bool) {
+	for hour := 23; hour >= 0; hour-- {
+		if c.hour&(1<<uint(hour)) == 0 || first && wallClock(year, month, day, hour, 0, 0, loc).After(end) {
+			continue
+		}
+		for minute := 59; minute >= 0; minute-- {
+			if c.minute&(1<<uint(minute)) == 0 || first && wallClock(year, month, day, hour, minute, 0, loc).After(end) {
+				continue
+			}
+			for second := 59; second >= 0; second-- {
+				if c.second&(1<<uint(second)) == 0 {
+					continue
+				}
+				if t := wallClock(year, month, day, hour, minute, second, loc); !t.After(end) {
+					return t, true
+				}
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// wallClock returns the time of the wall clock in the location.
+// If the wall clock does not exist because of DST, it returns the first instant after the gap like Vixie cron,
+// e.g. 02:30 on 2021-03-14 in America/New_York is 03:00 EDT.
+func wallClock(year int, month time.Month, day, hour, minute, second int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, hour, minute, second, 0, loc)
+	if h, m, s := t.Clock(); t.Day() == day && h == hour && m == minute && s == second {
+		return t
+	}
+
+	// Binary search the first instant whose wall clock is after the missing one. The gaps are shorter than a day.
+	wall := time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+	low, high := t.Add(-24*time.Hour), t.Add(24*time.Hour)
+	for high.Sub(low) > time.Second {
+		mid := low.Add(high.Sub(low) / 2)
+		if naiveWallClock(mid.In(loc)).After(wall) {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	return high.Truncate(time.Second)
+}
+
+// naiveWallClock returns the wall clock of t as UTC, so the wall clocks of different offsets can be compared.
+func naiveWallClock(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, t.Nanosecond(), time.UTC)
+}
+
+// addDate normalizes the date, e.g. October 32 becomes November 1.
+func addDate(year int, month time.Month, day int) (int, time.Month, int) {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Date()
+}
+
+func parseCron(spec string, loc *time.Location) (*CronSchedule, error) {
+	expr := strings.TrimSpace(spec)
+	if strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=") {
+		i := strings.IndexAny(expr, " \t")
+		if i < 0 {
+			return nil, ero.New("Missing fields after timezone")
+		}
+
+		zone := expr[strings.Index(expr, "=")+1 : i]
+		zoneLoc, err := LoadLocation(zone)
+		if err != nil {
+			return nil, ero.Wrap(err).AddContextf("Invalid timezone %s", zone)
+		}
+		loc = zoneLoc
+		expr = strings.TrimSpace(expr[i:])
+	}
+
+	if strings.HasPrefix(expr, "@every") {
+		value := strings.TrimSpace(strings.TrimPrefix(expr, "@every"))
+		every, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, ero.Wrap(err).AddContext("Invalid duration of @every")
+		}
+		if every <= 0 {
+			return nil, ero.Newf("Duration of @every must be positive but got %s", value)
+		}
+
+		return &CronSchedule{spec: spec, every: every, location: loc}, nil
+	}
+
+	if strings.HasPrefix(expr, "@") {
+		descriptor, ok := cronDescriptors[strings.ToLower(expr)]
+		if !ok {
+			return nil, ero.Newf("Unknown descriptor %s", expr)
+		}
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, ero.Newf("Expected 5 or 6 fields but got %d", len(fields))
+	}
+
+	schedule := &CronSchedule{spec: spec, location: loc}
+	targets := []struct {
+		field cronField
+		bits  *uint64
+	}{
+		{cronSecond, &schedule.second},
+		{cronMinute, &schedule.minute},
+		{cronHour, &schedule.hour},
+		{cronDom, &schedule.dom},
+		{cronMonth, &schedule.month},
+		{cronDow, &schedule.dow},
+	}
+	for i, target := range targets {
+		bits, err := target.field.parse(fields[i])
+		if err != nil {
+			return nil, err
+		}
+		*target.bits = bits
+	}
+
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow = schedule.dow&^(1<<7) | 1
+	}
+	schedule.domStar = fields[3][0] == '*' || fields[3][0] == '?'
+	schedule.dowStar = fields[5][0] == '*' || fields[5][0] == '?'
+
+	if schedule.dowStar && !schedule.canMatchDayOfMonth() {
+		return nil, ero.New("Day-of-month never exists in the months")
+	}
+
+	return schedule, nil
+}
+
+// canMatchDayOfMonth reports whether a day of month exists in one of the months, e.g. February 30 never exists.
+func (c *CronSchedule) canMatchDayOfMonth() bool {
+	daysInMonth := []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for month := 1; month <= 12; month++ {
+		if c.month&(1<<uint(month)) == 0 {
+			continue
+		}
+		for day := 1; day <= daysInMonth[month-1]; day++ {
+			if c.dom&(1<<uint(day)) != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parse returns the bits of the values of the field expression.
+func (f cronField) parse(expr string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		low, high, step, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+// parsePart parses "*", "?", "value", "low-high" with an optional "/step".
+func (f cronField) parsePart(part string) (low, high, step int, err error) {
+	rangePart, hasStep := part, false
+	step = 1
+	if i := strings.Index(part, "/"); i >= 0 {
+		rangePart, hasStep = part[:i], true
+		step, err = strconv.Atoi(part[i+1:])
+		if err != nil || step <= 0 {
+			return 0, 0, 0, ero.Newf("Invalid step %q of %s field", part, f.name)
+		}
+	}
+
+	switch {
+	case rangePart == "*" || rangePart == "?" && (f.name == cronDom.name || f.name == cronDow.name):
+		low, high = f.min, f.max
+		if f.name == cronDow.name {
+			high = 6
+		}
+	case strings.Contains(rangePart, "-"):
+		bounds := strings.SplitN(rangePart, "-", 2)
+		if low, err = f.parseValue(bounds[0]); err != nil {
+			return 0, 0, 0, err
+		}
+		if high, err = f.parseValue(bounds[1]); err != nil {
+			return 0, 0, 0, err
+		}
+		if low > high {
+			return 0, 0, 0, ero.Newf("Invalid range %q of %s field, start is greater than end", rangePart, f.name)
+		}
+	default:
+		if low, err = f.parseValue(rangePart); err != nil {
+			return 0, 0, 0, err
+		}
+		high = low
+		// "5/10" means from 5 to the max value every 10.
+		if hasStep {
+			high = f.max
+		}
+	}
+
+	return low, high, step, nil
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	if number, ok := f.names[strings.ToLower(value)]; ok {
+		return number, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, ero.Newf("Invalid value %q of %s field", value, f.name)
+	}
+	if number < f.min || number > f.max {
+		return 0, ero.Newf("Value %d of %s field is out of range [%d, %d]", number, f.name, f.min, f.max)
+	}
+
+	return number, nil
+}
@@ -0,0 +1,216 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron_InvalidExpression_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		spec        string
+		expectedErr string
+	}{
+		{spec: "* * *", expectedErr: "Invalid cron expression \"* * *\": Expected 5 or 6 fields but got 3"},
+		{spec: "61 * * * *", expectedErr: "Invalid cron expression \"61 * * * *\": Value 61 of minute field is out of range [0, 59]"},
+		{spec: "* 24 * * *", expectedErr: "Invalid cron expression \"* 24 * * *\": Value 24 of hour field is out of range [0, 23]"},
+		{spec: "* * 0 * *", expectedErr: "Invalid cron expression \"* * 0 * *\": Value 0 of day-of-month field is out of range [1, 31]"},
+		{spec: "* * * FOO *", expectedErr: "Invalid cron expression \"* * * FOO *\": Invalid value \"FOO\" of month field"},
+		{spec: "*/0 * * * *", expectedErr: "Invalid cron expression \"*/0 * * * *\": Invalid step \"*/0\" of minute field"},
+		{spec: "30-10 * * * *", expectedErr: "Invalid cron expression \"30-10 * * * *\": Invalid range \"30-10\" of minute field, start is greater than end"},
+		{spec: "? * * * *", expectedErr: "Invalid cron expression \"? * * * *\": Invalid value \"?\" of minute field"},
+		{spec: "0 0 30 2 *", expectedErr: "Invalid cron expression \"0 0 30 2 *\": Day-of-month never exists in the months"},
+		{spec: "@weekday", expectedErr: "Invalid cron expression \"@weekday\": Unknown descriptor @weekday"},
+		{spec: "@every -5m", expectedErr: "Invalid cron expression \"@every -5m\": Duration of @every must be positive but got -5m"},
+		{spec: "CRON_TZ=Asia/Not_Exist 0 0 * * *", expectedErr: "Invalid cron expression \"CRON_TZ=Asia/Not_Exist 0 0 * * *\": Invalid timezone Asia/Not_Exist: unknown time zone Asia/Not_Exist"},
+		{spec: "TZ=UTC", expectedErr: "Invalid cron expression \"TZ=UTC\": Missing fields after timezone"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		schedule, err := ParseCron(table.spec)
+
+		// THEN
+		assert.Nil(schedule)
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+
+	assert.Panics(func() {
+		MustParseCron("* * *")
+	})
+}
+
+func TestCronNext_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	// Saturday
+	input := time.Date(2021, 9, 11, 17, 55, 57, 780000000, time.UTC)
+	tables := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{spec: "* * * * *", expected: time.Date(2021, 9, 11, 17, 56, 0, 0, time.UTC)},
+		{spec: "* * * * * *", expected: time.Date(2021, 9, 11, 17, 55, 58, 0, time.UTC)},
+		{spec: "*/15 * * * *", expected: time.Date(2021, 9, 11, 18, 0, 0, 0, time.UTC)},
+		{spec: "30 9 * * MON-FRI", expected: time.Date(2021, 9, 13, 9, 30, 0, 0, time.UTC)},
+		{spec: "0 0 1,15 * *", expected: time.Date(2021, 9, 15, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 0 1 JAN ?", expected: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 12 * * 7", expected: time.Date(2021, 9, 12, 12, 0, 0, 0, time.UTC)},
+		{spec: "10/20 * * * * *", expected: time.Date(2021, 9, 11, 17, 56, 10, 0, time.UTC)},
+		// Day-of-month or day-of-week, Friday 2021-09-17 comes before 2021-09-20
+		{spec: "0 0 20 * FRI", expected: time.Date(2021, 9, 17, 0, 0, 0, 0, time.UTC)},
+		{spec: "@hourly", expected: time.Date(2021, 9, 11, 18, 0, 0, 0, time.UTC)},
+		{spec: "@daily", expected: time.Date(2021, 9, 12, 0, 0, 0, 0, time.UTC)},
+		{spec: "@weekly", expected: time.Date(2021, 9, 12, 0, 0, 0, 0, time.UTC)},
+		{spec: "@monthly", expected: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "@yearly", expected: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "@every 1h30m", expected: input.Add(90 * time.Minute)},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		schedule, err := ParseCron(table.spec)
+
+		// THEN
+		assert.Nil(err)
+		assert.Equal(table.expected, schedule.Next(input), table.spec)
+		assert.Equal(table.spec, schedule.String())
+	}
+}
+
+func TestCronPrev_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	input := time.Date(2021, 9, 11, 18, 0, 0, 0, time.UTC)
+	tables := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{spec: "* * * * *", expected: time.Date(2021, 9, 11, 17, 59, 0, 0, time.UTC)},
+		{spec: "0 18 * * *", expected: time.Date(2021, 9, 10, 18, 0, 0, 0, time.UTC)},
+		{spec: "30 9 * * MON-FRI", expected: time.Date(2021, 9, 10, 9, 30, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", expected: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{spec: "@every 5m", expected: input.Add(-5 * time.Minute)},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		schedule, err := ParseCron(table.spec)
+
+		// THEN
+		assert.Nil(err)
+		assert.Equal(table.expected, schedule.Prev(input), table.spec)
+	}
+
+	assert.Equal(input, MustParseCron("0 18 * * *").Prev(input.Add(time.Nanosecond)))
+}
+
+func TestCronNextN_SimpleInput_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	schedule := MustParseCron("0 9,18 * * *")
+	input := time.Date(2021, 9, 11, 10, 0, 0, 0, time.UTC)
+
+	// WHEN
+	actual := schedule.NextN(input, 3)
+
+	// THEN
+	assert.Equal([]time.Time{
+		time.Date(2021, 9, 11, 18, 0, 0, 0, time.UTC),
+		time.Date(2021, 9, 12, 9, 0, 0, 0, time.UTC),
+		time.Date(2021, 9, 12, 18, 0, 0, 0, time.UTC),
+	}, actual)
+}
+
+func TestCronNext_Timezone_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+	input := time.Date(2021, 9, 11, 20, 0, 0, 0, time.UTC)
+
+	// WHEN
+	prefixed := MustParseCron("CRON_TZ=Asia/Ho_Chi_Minh 0 8 * * *")
+	inLocation, err := ParseCronInLocation("0 8 * * *", hcm)
+
+	// THEN
+	assert.Nil(err)
+	// 2021-09-12 08:00 in Ho Chi Minh is 2021-09-12 01:00 UTC
+	assert.Equal(time.Date(2021, 9, 12, 1, 0, 0, 0, time.UTC), prefixed.Next(input))
+	assert.Equal(time.UTC, prefixed.Next(input).Location())
+	assert.Equal(time.Date(2021, 9, 12, 8, 0, 0, 0, hcm), inLocation.Next(input.In(hcm)))
+	assert.Equal(time.Date(2021, 9, 11, 1, 0, 0, 0, time.UTC), prefixed.Prev(input))
+}
+
+func TestCronNext_DSTTransition_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	newYork := MustLoadLocation("America/New_York")
+	schedule, err := ParseCronInLocation("30 1,2 * * *", newYork)
+
+	// WHEN
+	// 02:30 does not exist on 2021-03-14
+	spring := schedule.NextN(time.Date(2021, 3, 14, 0, 0, 0, 0, newYork), 2)
+	// 01:30 is repeated on 2021-11-07
+	fall := schedule.NextN(time.Date(2021, 11, 7, 0, 0, 0, 0, newYork), 2)
+
+	// THEN
+	assert.Nil(err)
+	assert.Equal("2021-03-14 01:30:00 EST", spring[0].Format("2006-01-02 15:04:05 MST"))
+	assert.Equal("2021-03-14 03:00:00 EDT", spring[1].Format("2006-01-02 15:04:05 MST"))
+	assert.Equal("2021-11-07 01:30:00 EDT", fall[0].Format("2006-01-02 15:04:05 MST"))
+	assert.Equal("2021-11-07 02:30:00 EST", fall[1].Format("2006-01-02 15:04:05 MST"))
+}
+
+func TestCronNext_DSTGap_RunAfterTransition(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	newYork := MustLoadLocation("America/New_York")
+	saoPaulo := MustLoadLocation("America/Sao_Paulo")
+	daily := MustParseCron("CRON_TZ=America/New_York 30 2 * * *")
+	quarterly := MustParseCron("CRON_TZ=America/New_York */15 2 * * *")
+	midnight := MustParseCron("CRON_TZ=America/Sao_Paulo 0 0 * * *")
+	local := MustParseCron("30 2 * * *")
+	beforeGap := time.Date(2021, 3, 14, 1, 59, 59, 0, newYork)
+	format := "2006-01-02 15:04:05 MST"
+
+	// WHEN
+	// 02:00-03:00 does not exist on 2021-03-14 in New York
+	runs := daily.NextN(time.Date(2021, 3, 13, 3, 0, 0, 0, newYork), 3)
+	quarterlyRuns := quarterly.NextN(time.Date(2021, 3, 14, 0, 0, 0, 0, newYork), 2)
+	prev := daily.Prev(time.Date(2021, 3, 14, 12, 0, 0, 0, newYork))
+	// 00:00-01:00 does not exist on 2018-11-04 in Sao Paulo
+	midnightRun := midnight.Next(time.Date(2018, 11, 3, 12, 0, 0, 0, saoPaulo))
+	// start is after the gap once it is rounded up to the next second
+	nextBeforeGap := local.Next(beforeGap)
+	prevAtGapEnd := local.Prev(time.Date(2021, 3, 14, 3, 0, 1, 0, newYork))
+	prevBeforeGap := local.Prev(beforeGap)
+
+	// THEN
+	assert.Equal("2021-03-14 03:00:00 EDT", runs[0].In(newYork).Format(format))
+	assert.Equal("2021-03-14 07:00:00 UTC", runs[0].UTC().Format(format))
+	assert.Equal("2021-03-15 02:30:00 EDT", runs[1].In(newYork).Format(format))
+	assert.Equal("2021-03-16 02:30:00 EDT", runs[2].In(newYork).Format(format))
+	assert.Equal("2021-03-14 03:00:00 EDT", quarterlyRuns[0].Format(format))
+	assert.Equal("2021-03-15 02:00:00 EDT", quarterlyRuns[1].Format(format))
+	assert.Equal("2021-03-14 03:00:00 EDT", prev.Format(format))
+	assert.Equal("2018-11-04 01:00:00 -02", midnightRun.Format(format))
+	assert.Equal("2021-03-14 03:00:00 EDT", nextBeforeGap.Format(format))
+	assert.Equal("2021-03-14 03:00:00 EDT", prevAtGapEnd.Format(format))
+	assert.Equal("2021-03-13 02:30:00 EST", prevBeforeGap.Format(format))
+}
+
+func BenchmarkCronNext(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	schedule := MustParseCron("30 9 * * MON-FRI")
+	input := time.Date(2021, 9, 11, 17, 55, 57, 0, time.UTC)
+
+	for i := 0; i < b.N; i++ {
+		schedule.Next(input)
+	}
+}
@@ -21,17 +21,32 @@ const (
 
 // GetCurrentLocalTime returns the current local time.
 func GetCurrentLocalTime() time.Time {
-	return time.Now()
+	return GetCurrentLocalTimeWithClock(DefaultClock())
+}
+
+// GetCurrentLocalTimeWithClock returns the current local time of the clock.
+func GetCurrentLocalTimeWithClock(clock Clock) time.Time {
+	return clock.Now().In(time.Local)
 }
 
 // GetCurrentMiliseconds returns the current milliseconds.
 func GetCurrentMiliseconds() int64 {
-	return ConvertLocalTimeToMilliseconds(GetCurrentLocalTime())
+	return GetCurrentMilisecondsWithClock(DefaultClock())
+}
+
+// GetCurrentMilisecondsWithClock returns the current milliseconds of the clock.
+func GetCurrentMilisecondsWithClock(clock Clock) int64 {
+	return ConvertLocalTimeToMilliseconds(GetCurrentLocalTimeWithClock(clock))
 }
 
 // ConvertCurrentLocalTimeToString converts the current local time to string with the specific format.
 func ConvertCurrentLocalTimeToString(format string) string {
-	return GetCurrentLocalTime().Format(format)
+	return ConvertCurrentLocalTimeToStringWithClock(DefaultClock(), format)
+}
+
+// ConvertCurrentLocalTimeToStringWithClock converts the current local time of the clock to string with the specific format.
+func ConvertCurrentLocalTimeToStringWithClock(clock Clock, format string) string {
+	return GetCurrentLocalTimeWithClock(clock).Format(format)
 }
 
 // ConvertMillisecondsToString converts the milliseconds to the string with the specific format.
@@ -71,53 +86,104 @@ func ConvertLocalTimeToString(localTime time.Time, format string) string {
 
 // GetYear returns the current year.
 func GetYear() int {
-	return GetCurrentLocalTime().Year()
+	return GetYearWithClock(DefaultClock())
+}
+
+// GetYearWithClock returns the current year of the clock.
+func GetYearWithClock(clock Clock) int {
+	return GetCurrentLocalTimeWithClock(clock).Year()
 }
 
 // GetDayOfYear returns the day of the year.
 func GetDayOfYear() int {
-	return GetCurrentLocalTime().YearDay()
+	return GetDayOfYearWithClock(DefaultClock())
+}
+
+// GetDayOfYearWithClock returns the day of the year of the clock.
+func GetDayOfYearWithClock(clock Clock) int {
+	return GetCurrentLocalTimeWithClock(clock).YearDay()
 }
 
 // GetDayOfMonth returns the day of month.
 func GetDayOfMonth() int {
-	return GetCurrentLocalTime().Day()
+	return GetDayOfMonthWithClock(DefaultClock())
+}
+
+// GetDayOfMonthWithClock returns the day of month of the clock.
+func GetDayOfMonthWithClock(clock Clock) int {
+	return GetCurrentLocalTimeWithClock(clock).Day()
 }
 
 // GetMonthOfYear returns the month of year. Value from 1 to 12.
 func GetMonthOfYear() int {
-	return int(GetCurrentLocalTime().Month())
+	return GetMonthOfYearWithClock(DefaultClock())
+}
 
+// GetMonthOfYearWithClock returns the month of year of the clock. Value from 1 to 12.
+func GetMonthOfYearWithClock(clock Clock) int {
+	return int(GetCurrentLocalTimeWithClock(clock).Month())
 }
 
 // GetStartLocalTimeOfYear returns the start local time of year.
 func GetStartLocalTimeOfYear() time.Time {
-	return time.Date(GetYear(), 1, 1, 0, 0, 0, 0, time.Local)
+	return GetStartLocalTimeOfYearWithClock(DefaultClock())
+}
+
+// GetStartLocalTimeOfYearWithClock returns the start local time of year of the clock.
+func GetStartLocalTimeOfYearWithClock(clock Clock) time.Time {
+	return time.Date(GetYearWithClock(clock), 1, 1, 0, 0, 0, 0, time.Local)
 }
 
 // GetEndLocalTimeOfYear returns the end local time of year.
 func GetEndLocalTimeOfYear() time.Time {
-	return time.Date(GetYear(), 12, 31, 23, 59, 59, 999999999, time.Local)
+	return GetEndLocalTimeOfYearWithClock(DefaultClock())
+}
+
+// GetEndLocalTimeOfYearWithClock returns the end local time of year of the clock.
+func GetEndLocalTimeOfYearWithClock(clock Clock) time.Time {
+	return time.Date(GetYearWithClock(clock), 12, 31, 23, 59, 59, 999999999, time.Local)
 }
 
 // GetStartLocalTimeOfMonth returns the start local time of month.
 func GetStartLocalTimeOfMonth() time.Time {
-	return time.Date(GetYear(), GetCurrentLocalTime().Month(), 1, 0, 0, 0, 0, time.Local)
+	return GetStartLocalTimeOfMonthWithClock(DefaultClock())
+}
+
+// GetStartLocalTimeOfMonthWithClock returns the start local time of month of the clock.
+func GetStartLocalTimeOfMonthWithClock(clock Clock) time.Time {
+	now := GetCurrentLocalTimeWithClock(clock)
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
 }
 
 // GetEndLocalTimeOfMonth returns the end local time of month.
 func GetEndLocalTimeOfMonth() time.Time {
-	return time.Date(GetYear(), GetCurrentLocalTime().Month(), 1, 23, 59, 59, 999999999, time.Local).AddDate(0, 1, -1)
+	return GetEndLocalTimeOfMonthWithClock(DefaultClock())
+}
+
+// GetEndLocalTimeOfMonthWithClock returns the end local time of month of the clock.
+func GetEndLocalTimeOfMonthWithClock(clock Clock) time.Time {
+	now := GetCurrentLocalTimeWithClock(clock)
+	return time.Date(now.Year(), now.Month(), 1, 23, 59, 59, 999999999, time.Local).AddDate(0, 1, -1)
 }
 
 // GetStartLocalTimeOfDay return the start local time of day.
 func GetStartLocalTimeOfDay() time.Time {
-	return time.Date(GetYear(), GetCurrentLocalTime().Month(), GetCurrentLocalTime().Day(), 0, 0, 0, 0, time.Local)
+	return GetStartLocalTimeOfDayWithClock(DefaultClock())
+}
+
+// GetStartLocalTimeOfDayWithClock return the start local time of day of the clock.
+func GetStartLocalTimeOfDayWithClock(clock Clock) time.Time {
+	return GetStartLocalTimeOfTime(GetCurrentLocalTimeWithClock(clock))
 }
 
 // GetEndLocalTimeOfDay return the end local time of day.
 func GetEndLocalTimeOfDay() time.Time {
-	return time.Date(GetYear(), GetCurrentLocalTime().Month(), GetCurrentLocalTime().Day(), 23, 59, 59, 999999999, time.Local)
+	return GetEndLocalTimeOfDayWithClock(DefaultClock())
+}
+
+// GetEndLocalTimeOfDayWithClock return the end local time of day of the clock.
+func GetEndLocalTimeOfDayWithClock(clock Clock) time.Time {
+	return GetEndLocalTimeOfTime(GetCurrentLocalTimeWithClock(clock))
 }
 
 // GetStartLocalTimeOfTime return the start local time of specific local time.
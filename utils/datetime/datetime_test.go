@@ -7,38 +7,60 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// fakeNowMillis is the current time of the tests using useFakeClock, 2021-09-11 17:55:57.780 in Asia/Ho_Chi_Minh.
+const fakeNowMillis int64 = 1631357757780
+
+// useFakeClock pins time.Local to Asia/Ho_Chi_Minh, the timezone of the expected values, and the default clock
+// to a FakeClock at fakeNowMillis, so the tests do not depend on the machine. They are restored when the test ends.
+func useFakeClock(t *testing.T) *FakeClock {
+	local := time.Local
+	time.Local = MustLoadLocation(AsiaHoChiMinh)
+	clock := NewFakeClock(time.UnixMilli(fakeNowMillis))
+	restore := SetDefaultClock(clock)
+	t.Cleanup(func() {
+		restore()
+		time.Local = local
+	})
+
+	return clock
+}
+
 func TestGetCurrentMiliseconds_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	millis := GetCurrentMiliseconds()
 
 	// THEN
-	assert.True(t, millis > 1631359343911)
+	assert.Equal(t, fakeNowMillis, millis)
 }
 
 func TestConvertCurrentLocalTimeToString_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	tables := []struct {
-		format string
+		format           string
+		expectedDatetime string
 	}{
-		{format: YYYY_MM_DD},
-		{format: YYYY_MM_DD_HH_MM_SS},
-		{format: YYYY_MM_DD_HH_MM_SS_SSS},
-		{format: DD_MM_YYYY},
-		{format: DD_MM_YYYY_HH_MM_SS},
-		{format: DD_MM_YYYY_HH_MM_SS_SSS},
+		{format: YYYY_MM_DD, expectedDatetime: "2021-09-11"},
+		{format: YYYY_MM_DD_HH_MM_SS, expectedDatetime: "2021-09-11 17:55:57"},
+		{format: YYYY_MM_DD_HH_MM_SS_SSS, expectedDatetime: "2021-09-11 17:55:57.780"},
+		{format: DD_MM_YYYY, expectedDatetime: "11-09-2021"},
+		{format: DD_MM_YYYY_HH_MM_SS, expectedDatetime: "11-09-2021 17:55:57"},
+		{format: DD_MM_YYYY_HH_MM_SS_SSS, expectedDatetime: "11-09-2021 17:55:57.780"},
 	}
 	for _, table := range tables {
 		// WHEN
 		datetime := ConvertCurrentLocalTimeToString(table.format)
 
 		// THEN
-		assert.NotEmpty(t, datetime)
+		assert.Equal(t, table.expectedDatetime, datetime)
 	}
 }
 
 func TestConvertMillisecondsToString_MultipleCase_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	tables := []struct {
 		millis           int64
@@ -88,6 +110,7 @@ func TestConvertMillisecondsToString_MultipleCase_Success(t *testing.T) {
 
 func TestConvertStringToMilliseconds_MultipleCase_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	tables := []struct {
 		actualDatetime string
@@ -137,6 +160,7 @@ func TestConvertStringToMilliseconds_MultipleCase_Success(t *testing.T) {
 
 func TestConvertStringToMilliseconds_WrongFormat_FailedToConvert(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	tables := []struct {
 		datetimeStr    string
@@ -182,6 +206,7 @@ func TestConvertStringToMilliseconds_WrongFormat_FailedToConvert(t *testing.T) {
 
 func TestConvertStringToLocalTime_MultipleCase_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	tables := []struct {
 		actualDatetime string
@@ -232,6 +257,7 @@ func TestConvertStringToLocalTime_MultipleCase_Success(t *testing.T) {
 
 func TestConvertStringToLocal_WrongFormat_FailedToConvert(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	tables := []struct {
 		datetimeStr    string
@@ -278,29 +304,32 @@ func TestConvertStringToLocal_WrongFormat_FailedToConvert(t *testing.T) {
 
 func TestConvertLocalTimeToMilliseconds_SimpleInput_Success(t *testing.T) {
 	// GIVEN
-	currentTime := time.Now()
+	useFakeClock(t)
+	currentTime := DefaultClock().Now()
 
 	// WHEN
 	millis := ConvertLocalTimeToMilliseconds(currentTime)
 
 	// THEN
-	assert.True(t, millis > 1631359343911)
+	assert.Equal(t, fakeNowMillis, millis)
 }
 
 func TestGetCurrentLocalTime_SimpleInput_Succss(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	// WHEN
 	currentTime := GetCurrentLocalTime()
 	millis := ConvertLocalTimeToMilliseconds(currentTime)
 
 	// THEN
-	assert.NotNil(currentTime)
-	assert.True(millis > 1631359343911)
+	assert.Equal(time.Local, currentTime.Location())
+	assert.Equal(fakeNowMillis, millis)
 }
 
 func TestConvertLocalTimeToString_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	localTime, _ := ConvertStringToLocalTime("09-09-2021", DD_MM_YYYY)
 
@@ -313,96 +342,107 @@ func TestConvertLocalTimeToString_SimpleInput_Success(t *testing.T) {
 
 func TestGetYear_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	year := GetYear()
 
 	// THEN
-	assert.True(t, year > 2020)
+	assert.Equal(t, 2021, year)
 }
 
 func TestGetDayOfYear_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	dayOfYear := GetDayOfYear()
 
 	// THEN
-	assert.True(t, dayOfYear >= 1 && dayOfYear < 366)
+	assert.Equal(t, 254, dayOfYear)
 }
 
 func TestGetDayOfMonth_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	dayOfMonth := GetDayOfMonth()
 
 	// THEN
-	assert.True(t, dayOfMonth >= 1 && dayOfMonth <= 31)
+	assert.Equal(t, 11, dayOfMonth)
 }
 
 func TestGetMonthOfYear_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	monthOfYear := GetMonthOfYear()
 
 	// THEN
-	assert.True(t, monthOfYear >= 1 && monthOfYear <= 12)
+	assert.Equal(t, 9, monthOfYear)
 }
 
 func TestGetStartLocalTimeOfYear_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	millis := ConvertLocalTimeToMilliseconds(GetStartLocalTimeOfYear())
 
 	// THEN
-	assert.True(t, millis > 1 && millis <= GetCurrentMiliseconds())
+	assert.Equal(t, int64(1609434000000), millis)
 }
 
 func TestGetEndLocalTimeOfYear_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	millis := ConvertLocalTimeToMilliseconds(GetEndLocalTimeOfYear())
 
 	// THEN
-	assert.True(t, millis >= GetCurrentMiliseconds())
+	assert.Equal(t, int64(1640969999999), millis)
 }
 
 func TestGetStartLocalTimeOfMonth_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	millis := ConvertLocalTimeToMilliseconds(GetStartLocalTimeOfMonth())
 
 	// THEN
-	assert.True(t, millis > 1 && millis <= GetCurrentMiliseconds())
+	assert.Equal(t, int64(1630429200000), millis)
 }
 
 func TestGetEndLocalTimeOfMonth_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	millis := ConvertLocalTimeToMilliseconds(GetEndLocalTimeOfMonth())
 
 	// THEN
-	assert.True(t, millis >= GetCurrentMiliseconds())
+	assert.Equal(t, int64(1633021199999), millis)
 }
 
 func TestGetStartLocalTimeOfDay_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	millis := ConvertLocalTimeToMilliseconds(GetStartLocalTimeOfDay())
 
 	// THEN
-	assert.True(t, millis > 1 && millis <= GetCurrentMiliseconds())
+	assert.Equal(t, int64(1631293200000), millis)
 }
 
 func TestGetEndLocalTimeOfDay_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	// WHEN
 	millis := ConvertLocalTimeToMilliseconds(GetEndLocalTimeOfDay())
 
 	// THEN
-	assert.True(t, millis >= GetCurrentMiliseconds())
+	assert.Equal(t, int64(1631379599999), millis)
 }
 
 func TestGetStartLocalTimeOfTime_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	localTime, _ := ConvertStringToLocalTime("09-09-2021", DD_MM_YYYY)
 
 	// WHEN
@@ -414,6 +454,7 @@ func TestGetStartLocalTimeOfTime_SimpleInput_Success(t *testing.T) {
 
 func TestGetEndLocalTimeOfTime_SimpleInput_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	localTime, _ := ConvertStringToLocalTime("09-09-2021", DD_MM_YYYY)
 
 	// WHEN
@@ -425,6 +466,7 @@ func TestGetEndLocalTimeOfTime_SimpleInput_Success(t *testing.T) {
 
 func TestGetBeforeLocalTimeOfTime_MultipleCase_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	tables := []struct {
 		datetimeStr    string
@@ -587,6 +629,7 @@ func TestGetBeforeLocalTimeOfTime_MultipleCase_Success(t *testing.T) {
 
 func TestGetAfterLocalTimeOfTime_MultipleCase_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	tables := []struct {
 		datetimeStr    string
@@ -749,6 +792,7 @@ func TestGetAfterLocalTimeOfTime_MultipleCase_Success(t *testing.T) {
 
 func TestGetMillisecondsBetween_MultipleCase_Success(t *testing.T) {
 	// GIVEN
+	useFakeClock(t)
 	assert := assert.New(t)
 	tables := []struct {
 		startDatetimeStr string
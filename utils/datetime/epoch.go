@@ -0,0 +1,102 @@
+package datetime
+
+import (
+	"math"
+	"time"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// EpochUnit is the unit of epoch value, the time elapsed since January 1, 1970 UTC.
+type EpochUnit int
+
+const (
+	// EpochSeconds is the epoch in seconds, e.g. the most of APIs.
+	EpochSeconds EpochUnit = iota
+	// EpochMilliseconds is the epoch in milliseconds, e.g. Kafka timestamps.
+	EpochMilliseconds
+	// EpochMicroseconds is the epoch in microseconds, e.g. tracing spans.
+	EpochMicroseconds
+	// EpochNanoseconds is the epoch in nanoseconds.
+	EpochNanoseconds
+)
+
+var epochUnitNames = map[EpochUnit]string{
+	EpochSeconds:      "seconds",
+	EpochMilliseconds: "milliseconds",
+	EpochMicroseconds: "microseconds",
+	EpochNanoseconds:  "nanoseconds",
+}
+
+// String returns the name of unit such as "milliseconds".
+func (u EpochUnit) String() string {
+	if name, ok := epochUnitNames[u]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// perSecond returns the number of unit in a second.
+func (u EpochUnit) perSecond() (int64, bool) {
+	switch u {
+	case EpochSeconds:
+		return 1, true
+	case EpochMilliseconds:
+		return 1e3, true
+	case EpochMicroseconds:
+		return 1e6, true
+	case EpochNanoseconds:
+		return 1e9, true
+	}
+
+	return 0, false
+}
+
+// ToEpoch converts t to the epoch in the unit. The sub-unit part is truncated.
+// It returns an error if the epoch overflows int64, e.g. nanoseconds only cover the years from 1678 to 2262.
+func ToEpoch(t time.Time, unit EpochUnit) (int64, error) {
+	perSecond, ok := unit.perSecond()
+	if !ok {
+		return 0, ero.Newf("Unknown epoch unit %d", unit)
+	}
+
+	seconds := t.Unix()
+	fraction := int64(t.Nanosecond()) / (1e9 / perSecond)
+	if seconds > (math.MaxInt64-fraction)/perSecond || seconds < math.MinInt64/perSecond {
+		return 0, ero.Newf("Time %s overflows epoch %s", t.Format(time.RFC3339Nano), unit)
+	}
+
+	return seconds*perSecond + fraction, nil
+}
+
+// FromEpoch converts the epoch in the unit to the local time.
+func FromEpoch(value int64, unit EpochUnit) (time.Time, error) {
+	perSecond, ok := unit.perSecond()
+	if !ok {
+		return time.Time{}, ero.Newf("Unknown epoch unit %d", unit)
+	}
+
+	return time.Unix(value/perSecond, value%perSecond*(1e9/perSecond)), nil
+}
+
+// DetectEpochUnit guesses the unit of epoch from its magnitude, assuming the time is between 1973 and 5138:
+// less than 1e11 is seconds, less than 1e14 is milliseconds, less than 1e17 is microseconds, otherwise nanoseconds.
+// It is a heuristic for the mixed data sources, the unit should be known whenever possible.
+func DetectEpochUnit(value int64) EpochUnit {
+	abs := uint64(value)
+	if value < 0 {
+		abs = uint64(-(value + 1)) + 1
+	}
+
+	switch {
+	case abs < 1e11:
+		return EpochSeconds
+	case abs < 1e14:
+		return EpochMilliseconds
+	case abs < 1e17:
+		return EpochMicroseconds
+	default:
+		return EpochNanoseconds
+	}
+}
@@ -0,0 +1,128 @@
+package datetime
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToEpochAndFromEpoch_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	input := time.Date(2021, 9, 11, 10, 55, 57, 780123456, time.UTC)
+	tables := []struct {
+		unit     EpochUnit
+		expected int64
+		name     string
+	}{
+		{unit: EpochSeconds, expected: 1631357757, name: "seconds"},
+		{unit: EpochMilliseconds, expected: 1631357757780, name: "milliseconds"},
+		{unit: EpochMicroseconds, expected: 1631357757780123, name: "microseconds"},
+		{unit: EpochNanoseconds, expected: 1631357757780123456, name: "nanoseconds"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		epoch, errTo := ToEpoch(input, table.unit)
+		actual, errFrom := FromEpoch(table.expected, table.unit)
+
+		// THEN
+		assert.Nil(errTo)
+		assert.Nil(errFrom)
+		assert.Equal(table.expected, epoch)
+		assert.Equal(table.name, table.unit.String())
+		assert.True(input.Truncate(time.Second/time.Duration(mustPerSecond(table.unit))).Equal(actual))
+		assert.Equal(time.Local, actual.Location())
+	}
+}
+
+func TestToEpochAndFromEpoch_BeforeEpoch_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	input := time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC)
+
+	// WHEN
+	millis, errTo := ToEpoch(input, EpochMilliseconds)
+	actual, errFrom := FromEpoch(-500, EpochMilliseconds)
+
+	// THEN
+	assert.Nil(errTo)
+	assert.Nil(errFrom)
+	assert.Equal(int64(-500), millis)
+	assert.True(input.Equal(actual))
+	assert.Equal(ConvertLocalTimeToMilliseconds(input), millis)
+}
+
+func TestToEpoch_Overflow_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		input       time.Time
+		unit        EpochUnit
+		expectedErr string
+	}{
+		{input: time.Date(2263, 1, 1, 0, 0, 0, 0, time.UTC), unit: EpochNanoseconds, expectedErr: "Time 2263-01-01T00:00:00Z overflows epoch nanoseconds"},
+		{input: time.Date(1677, 1, 1, 0, 0, 0, 0, time.UTC), unit: EpochNanoseconds, expectedErr: "Time 1677-01-01T00:00:00Z overflows epoch nanoseconds"},
+		{input: time.Unix(math.MaxInt64/1000+1, 0).UTC(), unit: EpochMilliseconds, expectedErr: "Time " + time.Unix(math.MaxInt64/1000+1, 0).UTC().Format(time.RFC3339Nano) + " overflows epoch milliseconds"},
+		{input: time.Now(), unit: EpochUnit(9), expectedErr: "Unknown epoch unit 9"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		_, err := ToEpoch(table.input, table.unit)
+
+		// THEN
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+
+	_, err := FromEpoch(1, EpochUnit(9))
+	assert.NotNil(err)
+	assert.Equal("unknown", EpochUnit(9).String())
+
+	maxNanos, err := ToEpoch(time.Unix(0, math.MaxInt64), EpochNanoseconds)
+	assert.Nil(err)
+	assert.Equal(int64(math.MaxInt64), maxNanos)
+}
+
+func TestDetectEpochUnit_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		input    int64
+		expected EpochUnit
+	}{
+		{input: 0, expected: EpochSeconds},
+		{input: 1631357757, expected: EpochSeconds},
+		{input: -1631357757, expected: EpochSeconds},
+		{input: 1631357757780, expected: EpochMilliseconds},
+		{input: 1631357757780123, expected: EpochMicroseconds},
+		{input: 1631357757780123456, expected: EpochNanoseconds},
+		{input: math.MinInt64, expected: EpochNanoseconds},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := DetectEpochUnit(table.input)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func mustPerSecond(unit EpochUnit) int64 {
+	perSecond, _ := unit.perSecond()
+	return perSecond
+}
+
+func BenchmarkToEpoch(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	now := time.Now()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ToEpoch(now, EpochMicroseconds)
+	}
+}
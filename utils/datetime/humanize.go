@@ -0,0 +1,258 @@
+package datetime
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phamtai97/go-utils/internal/i18n"
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+const (
+	// LocaleEN is the English locale, the same as ero.LocaleEN.
+	LocaleEN = i18n.EN
+	// LocaleVI is the Vietnamese locale, the same as ero.LocaleVI.
+	LocaleVI = i18n.VI
+
+	// Day is the duration of 24 hours.
+	Day = 24 * time.Hour
+	// Week is the duration of 7 days.
+	Week = 7 * Day
+)
+
+// UnitNames are the singular and plural names of a time unit.
+type UnitNames struct {
+	One   string
+	Other string
+}
+
+// HumanizeLocale is the words of a language used by HumanizeWithLocale.
+//
+// Past and Future are the templates of relative time, the placeholder {duration} is replaced by the amount and the unit, e.g.
+//
+// 	datetime.HumanizeLocale{
+// 		JustNow: "just now",
+// 		Past:    "{duration} ago",
+// 		Future:  "in {duration}",
+// 		Second:  datetime.UnitNames{One: "second", Other: "seconds"},
+// 		...
+// 	}
+type HumanizeLocale struct {
+	JustNow string
+	Past    string
+	Future  string
+	Second  UnitNames
+	Minute  UnitNames
+	Hour    UnitNames
+	Day     UnitNames
+	Week    UnitNames
+	Month   UnitNames
+	Year    UnitNames
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]HumanizeLocale{
+		LocaleEN: {
+			JustNow: "just now",
+			Past:    "{duration} ago",
+			Future:  "in {duration}",
+			Second:  UnitNames{One: "second", Other: "seconds"},
+			Minute:  UnitNames{One: "minute", Other: "minutes"},
+			Hour:    UnitNames{One: "hour", Other: "hours"},
+			Day:     UnitNames{One: "day", Other: "days"},
+			Week:    UnitNames{One: "week", Other: "weeks"},
+			Month:   UnitNames{One: "month", Other: "months"},
+			Year:    UnitNames{One: "year", Other: "years"},
+		},
+		LocaleVI: {
+			JustNow: "vừa xong",
+			Past:    "{duration} trước",
+			Future:  "{duration} nữa",
+			Second:  UnitNames{One: "giây", Other: "giây"},
+			Minute:  UnitNames{One: "phút", Other: "phút"},
+			Hour:    UnitNames{One: "giờ", Other: "giờ"},
+			Day:     UnitNames{One: "ngày", Other: "ngày"},
+			Week:    UnitNames{One: "tuần", Other: "tuần"},
+			Month:   UnitNames{One: "tháng", Other: "tháng"},
+			Year:    UnitNames{One: "năm", Other: "năm"},
+		},
+	}
+)
+
+// RegisterHumanizeLocale adds or replaces the locale used by HumanizeWithLocale, e.g. "fr" or "pt-BR".
+func RegisterHumanizeLocale(locale string, words HumanizeLocale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[i18n.Normalize(locale)] = words
+}
+
+// Humanize returns the relative time of t compared to now in English, such as "3 minutes ago" or "in 2 days".
+func Humanize(t, now time.Time) string {
+	return HumanizeWithLocale(t, now, LocaleEN)
+}
+
+// HumanizeWithLocale returns the relative time of t compared to now in the locale.
+// If the locale is not registered, its base language such as "vi" of "vi-VN" is used, then English.
+//
+// The amount is rounded down to the largest unit: less than 10 seconds is "just now",
+// then seconds, minutes, hours, days, weeks, months of 30 days and years of 365 days.
+func HumanizeWithLocale(t, now time.Time, locale string) string {
+	words := lookupLocale(locale)
+	diff := t.Sub(now)
+	abs := diff
+	if abs < 0 {
+		abs = -abs
+	}
+
+	if abs < 10*time.Second {
+		return words.JustNow
+	}
+
+	var amount int64
+	var unit UnitNames
+	switch {
+	case abs < time.Minute:
+		amount, unit = int64(abs/time.Second), words.Second
+	case abs < time.Hour:
+		amount, unit = int64(abs/time.Minute), words.Minute
+	case abs < Day:
+		amount, unit = int64(abs/time.Hour), words.Hour
+	case abs < Week:
+		amount, unit = int64(abs/Day), words.Day
+	case abs < 30*Day:
+		amount, unit = int64(abs/Week), words.Week
+	case abs < 365*Day:
+		amount, unit = int64(abs/(30*Day)), words.Month
+	default:
+		amount, unit = int64(abs/(365*Day)), words.Year
+	}
+
+	name := unit.Other
+	if amount == 1 {
+		name = unit.One
+	}
+	duration := strconv.FormatInt(amount, 10) + " " + name
+
+	template := words.Future
+	if diff < 0 {
+		template = words.Past
+	}
+
+	return strings.Replace(template, "{duration}", duration, -1)
+}
+
+// FormatDuration returns the compact form of the duration such as "1h 23m" or "2d 4h 5s 300ms".
+// The zero units are omitted. The duration less than 1 millisecond is formatted by time.Duration.String.
+func FormatDuration(d time.Duration) string {
+	if d > -time.Millisecond && d < time.Millisecond {
+		return d.String()
+	}
+
+	sign := ""
+	if d < 0 {
+		sign = "-"
+	}
+
+	units := []struct {
+		size   time.Duration
+		suffix string
+	}{
+		{Day, "d"},
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
+		{time.Millisecond, "ms"},
+	}
+
+	parts := make([]string, 0, len(units))
+	for _, unit := range units {
+		// The amount keeps the sign of d because the absolute value of math.MinInt64 overflows.
+		amount := int64(d / unit.size)
+		d %= unit.size
+		if amount < 0 {
+			amount = -amount
+		}
+		if amount > 0 {
+			parts = append(parts, strconv.FormatInt(amount, 10)+unit.suffix)
+		}
+	}
+
+	return sign + strings.Join(parts, " ")
+}
+
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond, "nanosecond": time.Nanosecond, "nanoseconds": time.Nanosecond,
+	"us": time.Microsecond, "µs": time.Microsecond, "microsecond": time.Microsecond, "microseconds": time.Microsecond,
+	"ms": time.Millisecond, "millisecond": time.Millisecond, "milliseconds": time.Millisecond,
+	"s": time.Second, "sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": Day, "day": Day, "days": Day,
+	"w": Week, "week": Week, "weeks": Week,
+}
+
+// ParseHumanDuration parses the human duration such as "1d12h", "2 weeks", "1.5 hours" or "1h 30m 10s".
+// It accepts the units of time.ParseDuration, their English names, "d" for day and "w" for week.
+// Months and years are not accepted because their length varies.
+func ParseHumanDuration(value string) (time.Duration, error) {
+	input := strings.ToLower(strings.TrimSpace(value))
+	sign := 1.0
+	if strings.HasPrefix(input, "-") || strings.HasPrefix(input, "+") {
+		if input[0] == '-' {
+			sign = -1
+		}
+		input = strings.TrimSpace(input[1:])
+	}
+	if len(input) == 0 {
+		return 0, ero.Newf("Invalid duration %q", value)
+	}
+
+	total := 0.0
+	for len(input) > 0 {
+		i := 0
+		for i < len(input) && (input[i] >= '0' && input[i] <= '9' || input[i] == '.') {
+			i++
+		}
+		amount, err := strconv.ParseFloat(input[:i], 64)
+		if err != nil {
+			return 0, ero.Newf("Invalid duration %q", value)
+		}
+		input = strings.TrimLeft(input[i:], " ")
+
+		i = 0
+		for i < len(input) && (input[i] < '0' || input[i] > '9') && input[i] != ' ' && input[i] != ',' {
+			i++
+		}
+		if i == 0 {
+			return 0, ero.Newf("Missing unit in duration %q", value)
+		}
+		unit, ok := durationUnits[input[:i]]
+		if !ok {
+			return 0, ero.Newf("Unknown unit %q in duration %q", input[:i], value)
+		}
+		input = strings.TrimLeft(input[i:], " ,")
+
+		total += amount * float64(unit)
+		if total >= math.MaxInt64 {
+			return 0, ero.Newf("Duration %q overflows", value)
+		}
+	}
+
+	return time.Duration(math.Round(sign * total)), nil
+}
+
+func lookupLocale(locale string) HumanizeLocale {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	if words, ok := i18n.Lookup(locales, locale); ok {
+		return words
+	}
+
+	return locales[LocaleEN]
+}
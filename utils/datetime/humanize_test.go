@@ -0,0 +1,153 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHumanize_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	now := time.Date(2021, 9, 11, 17, 55, 57, 0, time.Local)
+	tables := []struct {
+		input      time.Time
+		expectedEN string
+		expectedVI string
+	}{
+		{input: now, expectedEN: "just now", expectedVI: "vừa xong"},
+		{input: now.Add(-9 * time.Second), expectedEN: "just now", expectedVI: "vừa xong"},
+		{input: now.Add(-30 * time.Second), expectedEN: "30 seconds ago", expectedVI: "30 giây trước"},
+		{input: now.Add(-time.Minute), expectedEN: "1 minute ago", expectedVI: "1 phút trước"},
+		{input: now.Add(-3*time.Minute - 59*time.Second), expectedEN: "3 minutes ago", expectedVI: "3 phút trước"},
+		{input: now.Add(90 * time.Minute), expectedEN: "in 1 hour", expectedVI: "1 giờ nữa"},
+		{input: now.Add(2 * Day), expectedEN: "in 2 days", expectedVI: "2 ngày nữa"},
+		{input: now.Add(-15 * Day), expectedEN: "2 weeks ago", expectedVI: "2 tuần trước"},
+		{input: now.Add(-60 * Day), expectedEN: "2 months ago", expectedVI: "2 tháng trước"},
+		{input: now.Add(400 * Day), expectedEN: "in 1 year", expectedVI: "1 năm nữa"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actualEN := Humanize(table.input, now)
+		actualVI := HumanizeWithLocale(table.input, now, LocaleVI)
+
+		// THEN
+		assert.Equal(table.expectedEN, actualEN)
+		assert.Equal(table.expectedVI, actualVI)
+	}
+}
+
+func TestHumanizeWithLocale_FallbackAndRegister_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	now := time.Date(2021, 9, 11, 17, 55, 57, 0, time.Local)
+	RegisterHumanizeLocale("fr", HumanizeLocale{
+		JustNow: "à l'instant",
+		Past:    "il y a {duration}",
+		Future:  "dans {duration}",
+		Second:  UnitNames{One: "seconde", Other: "secondes"},
+		Minute:  UnitNames{One: "minute", Other: "minutes"},
+		Hour:    UnitNames{One: "heure", Other: "heures"},
+		Day:     UnitNames{One: "jour", Other: "jours"},
+		Week:    UnitNames{One: "semaine", Other: "semaines"},
+		Month:   UnitNames{One: "mois", Other: "mois"},
+		Year:    UnitNames{One: "an", Other: "ans"},
+	})
+
+	// WHEN
+	// THEN
+	assert.Equal("3 ngày trước", HumanizeWithLocale(now.Add(-3*Day), now, "vi_VN"))
+	assert.Equal("3 days ago", HumanizeWithLocale(now.Add(-3*Day), now, "ja"))
+	assert.Equal("il y a 3 jours", HumanizeWithLocale(now.Add(-3*Day), now, "FR-ca"))
+}
+
+func TestFormatDuration_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{input: 0, expected: "0s"},
+		{input: 1500 * time.Nanosecond, expected: "1.5µs"},
+		{input: 300 * time.Millisecond, expected: "300ms"},
+		{input: time.Hour + 23*time.Minute, expected: "1h 23m"},
+		{input: -(time.Hour + 23*time.Minute), expected: "-1h 23m"},
+		{input: 2*Day + 4*time.Hour + 5*time.Second + 300*time.Millisecond, expected: "2d 4h 5s 300ms"},
+		{input: 90 * Day, expected: "90d"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := FormatDuration(table.input)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func TestParseHumanDuration_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{input: "1d12h", expected: 36 * time.Hour},
+		{input: "2 weeks", expected: 14 * Day},
+		{input: "1.5 hours", expected: 90 * time.Minute},
+		{input: "1h 30m 10s", expected: time.Hour + 30*time.Minute + 10*time.Second},
+		{input: "1 day, 2 hours", expected: 26 * time.Hour},
+		{input: "-5 mins", expected: -5 * time.Minute},
+		{input: "250ms", expected: 250 * time.Millisecond},
+		{input: "0.1s", expected: 100 * time.Millisecond},
+		{input: "1W", expected: Week},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := ParseHumanDuration(table.input)
+
+		// THEN
+		assert.Nil(err, table.input)
+		assert.Equal(table.expected, actual, table.input)
+	}
+}
+
+func TestParseHumanDuration_InvalidInput_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		input       string
+		expectedErr string
+	}{
+		{input: "", expectedErr: "Invalid duration \"\""},
+		{input: "abc", expectedErr: "Invalid duration \"abc\""},
+		{input: "5", expectedErr: "Missing unit in duration \"5\""},
+		{input: "2 months", expectedErr: "Unknown unit \"months\" in duration \"2 months\""},
+		{input: "1d 2", expectedErr: "Missing unit in duration \"1d 2\""},
+		{input: "300000 weeks", expectedErr: "Duration \"300000 weeks\" overflows"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		_, err := ParseHumanDuration(table.input)
+
+		// THEN
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+}
+
+func BenchmarkHumanize(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	now := time.Now()
+	input := now.Add(-3 * time.Minute)
+
+	for i := 0; i < b.N; i++ {
+		Humanize(input, now)
+	}
+}
@@ -0,0 +1,188 @@
+package datetime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+const (
+	// MM_DD_YYYY is the format "01-02-2006".
+	MM_DD_YYYY = "01-02-2006"
+	// MM_DD_YYYY_HH_MM_SS is the format "01-02-2006 15:04:05".
+	MM_DD_YYYY_HH_MM_SS = "01-02-2006 15:04:05"
+	// MM_DD_YYYY_HH_MM_SS_SSS is the format "01-02-2006 15:04:05.000".
+	MM_DD_YYYY_HH_MM_SS_SSS = "01-02-2006 15:04:05.000"
+
+	// LayoutUnixSeconds is the pseudo layout of the epoch seconds such as "1631357757".
+	LayoutUnixSeconds = "UNIX_SECONDS"
+	// LayoutUnixMilliseconds is the pseudo layout of the epoch milliseconds such as "1631357757780".
+	LayoutUnixMilliseconds = "UNIX_MILLISECONDS"
+	// LayoutUnixMicroseconds is the pseudo layout of the epoch microseconds such as "1631357757780000".
+	LayoutUnixMicroseconds = "UNIX_MICROSECONDS"
+	// LayoutUnixNanoseconds is the pseudo layout of the epoch nanoseconds such as "1631357757780000000".
+	LayoutUnixNanoseconds = "UNIX_NANOSECONDS"
+)
+
+var epochLayouts = map[string]EpochUnit{
+	LayoutUnixSeconds:      EpochSeconds,
+	LayoutUnixMilliseconds: EpochMilliseconds,
+	LayoutUnixMicroseconds: EpochMicroseconds,
+	LayoutUnixNanoseconds:  EpochNanoseconds,
+}
+
+// DateOrder is the preferred order of day and month to resolve ambiguous input such as "01-02-2026".
+type DateOrder int
+
+const (
+	// DateOrderNone rejects ambiguous input.
+	DateOrderNone DateOrder = iota
+	// DateOrderDayFirst prefers the layouts with day before month such as DD_MM_YYYY.
+	DateOrderDayFirst
+	// DateOrderMonthFirst prefers the layouts with month before day such as MM_DD_YYYY.
+	DateOrderMonthFirst
+)
+
+// ParseOptions allows users to configure ParseAnyWithOptions.
+type ParseOptions struct {
+	// Layouts are tried in order. If it is empty, DefaultParseLayouts is used.
+	// The pseudo layouts such as LayoutUnixSeconds or LayoutUnixMilliseconds can be added to accept epoch values.
+	Layouts []string
+	// Location is used for the layouts without timezone. If it is nil, time.Local is used.
+	Location *time.Location
+	// DateOrder resolves ambiguous input. If it is DateOrderNone, ambiguous input is rejected.
+	DateOrder DateOrder
+}
+
+var defaultParseLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	YYYY_MM_DD_HH_MM_SS_SSS,
+	YYYY_MM_DD_HH_MM_SS,
+	YYYY_MM_DD,
+	DD_MM_YYYY_HH_MM_SS_SSS,
+	DD_MM_YYYY_HH_MM_SS,
+	DD_MM_YYYY,
+	MM_DD_YYYY_HH_MM_SS_SSS,
+	MM_DD_YYYY_HH_MM_SS,
+	MM_DD_YYYY,
+	"02/01/2006 15:04:05",
+	"02/01/2006",
+	"01/02/2006 15:04:05",
+	"01/02/2006",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.ANSIC,
+	time.UnixDate,
+	time.RubyDate,
+	LayoutUnixSeconds,
+	LayoutUnixMilliseconds,
+	LayoutUnixMicroseconds,
+	LayoutUnixNanoseconds,
+}
+
+// DefaultParseLayouts returns the layouts tried by ParseAny in order:
+// ISO 8601, the package formats, the formats with month before day, RFC 1123 and other standard formats and the epoch values.
+func DefaultParseLayouts() []string {
+	return append([]string(nil), defaultParseLayouts...)
+}
+
+// ParseAny parses the value with DefaultParseLayouts and returns the time and the matched layout.
+// The value without timezone is parsed in the local location.
+// Ambiguous value such as "01-02-2026", which is both January 2 and February 1, is rejected.
+func ParseAny(value string) (time.Time, string, error) {
+	return ParseAnyWithOptions(value, ParseOptions{})
+}
+
+// ParseAnyWithOptions parses the value with the options and returns the time and the matched layout.
+// If many layouts give the same time, the first one is returned.
+// If they give different times, the first layout with the preferred date order is returned,
+// or an error if there is no preference.
+func ParseAnyWithOptions(value string, opts ParseOptions) (time.Time, string, error) {
+	value = strings.TrimSpace(value)
+	layouts := opts.Layouts
+	if len(layouts) == 0 {
+		layouts = defaultParseLayouts
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	var matches []parseMatch
+	for _, layout := range layouts {
+		if t, ok := parseLayout(value, layout, loc); ok {
+			matches = append(matches, parseMatch{time: t, layout: layout})
+		}
+	}
+
+	if len(matches) == 0 {
+		return time.Time{}, "", ero.Newf("Unknown format of datetime %q", value)
+	}
+
+	first := matches[0]
+	for _, match := range matches[1:] {
+		if match.time.Equal(first.time) {
+			continue
+		}
+
+		if opts.DateOrder == DateOrderNone {
+			return time.Time{}, "", ero.Newf("Ambiguous datetime %q matches both %q and %q, set the date order to choose one", value, first.layout, match.layout)
+		}
+		for _, preferred := range matches {
+			if layoutDateOrder(preferred.layout) == opts.DateOrder {
+				return preferred.time, preferred.layout, nil
+			}
+		}
+
+		return time.Time{}, "", ero.Newf("Ambiguous datetime %q has no layout with the preferred date order", value)
+	}
+
+	return first.time, first.layout, nil
+}
+
+type parseMatch struct {
+	time   time.Time
+	layout string
+}
+
+func parseLayout(value, layout string, loc *time.Location) (time.Time, bool) {
+	if unit, ok := epochLayouts[layout]; ok {
+		return parseEpoch(value, unit, loc)
+	}
+
+	t, err := time.ParseInLocation(layout, value, loc)
+	return t, err == nil
+}
+
+// parseEpoch accepts the epoch value whose unit detected by DetectEpochUnit is the unit.
+// The values less than 1e8 are rejected, so that the short numbers are not read as the times in 1970.
+func parseEpoch(value string, unit EpochUnit, loc *time.Location) (time.Time, bool) {
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < 1e8 || DetectEpochUnit(number) != unit {
+		return time.Time{}, false
+	}
+
+	t, err := FromEpoch(number, unit)
+	return t.In(loc), err == nil
+}
+
+// layoutDateOrder returns the date order of the numeric day "02" and month "01" in the layout.
+func layoutDateOrder(layout string) DateOrder {
+	day := strings.Index(layout, "02")
+	month := strings.Index(layout, "01")
+	switch {
+	case day < 0 || month < 0:
+		return DateOrderNone
+	case day < month:
+		return DateOrderDayFirst
+	default:
+		return DateOrderMonthFirst
+	}
+}
@@ -0,0 +1,135 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAny_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	utc := MustLoadLocation(UTC)
+	tables := []struct {
+		input          string
+		expected       time.Time
+		expectedLayout string
+	}{
+		{input: "2021-09-11T10:55:57Z", expected: time.Date(2021, 9, 11, 10, 55, 57, 0, utc), expectedLayout: time.RFC3339},
+		{input: "2021-09-11T17:55:57.78+07:00", expected: time.Date(2021, 9, 11, 10, 55, 57, 780000000, utc), expectedLayout: time.RFC3339},
+		{input: "2021-09-11T10:55:57", expected: time.Date(2021, 9, 11, 10, 55, 57, 0, utc), expectedLayout: "2006-01-02T15:04:05"},
+		{input: "2021-09-11 10:55:57.780", expected: time.Date(2021, 9, 11, 10, 55, 57, 780000000, utc), expectedLayout: YYYY_MM_DD_HH_MM_SS_SSS},
+		{input: "2021-09-11", expected: time.Date(2021, 9, 11, 0, 0, 0, 0, utc), expectedLayout: YYYY_MM_DD},
+		{input: " 25-09-2021 ", expected: time.Date(2021, 9, 25, 0, 0, 0, 0, utc), expectedLayout: DD_MM_YYYY},
+		{input: "13-09-2021 10:55:57", expected: time.Date(2021, 9, 13, 10, 55, 57, 0, utc), expectedLayout: DD_MM_YYYY_HH_MM_SS},
+		{input: "09-13-2021", expected: time.Date(2021, 9, 13, 0, 0, 0, 0, utc), expectedLayout: MM_DD_YYYY},
+		// Both orders give the same time
+		{input: "09-09-2021", expected: time.Date(2021, 9, 9, 0, 0, 0, 0, utc), expectedLayout: DD_MM_YYYY},
+		{input: "13/09/2021", expected: time.Date(2021, 9, 13, 0, 0, 0, 0, utc), expectedLayout: "02/01/2006"},
+		{input: "Sat, 11 Sep 2021 10:55:57 +0000", expected: time.Date(2021, 9, 11, 10, 55, 57, 0, utc), expectedLayout: time.RFC1123Z},
+		{input: "Sat, 11 Sep 2021 10:55:57 UTC", expected: time.Date(2021, 9, 11, 10, 55, 57, 0, utc), expectedLayout: time.RFC1123},
+		{input: "1631357757", expected: time.Date(2021, 9, 11, 10, 55, 57, 0, utc), expectedLayout: LayoutUnixSeconds},
+		{input: "1631357757780", expected: time.Date(2021, 9, 11, 10, 55, 57, 780000000, utc), expectedLayout: LayoutUnixMilliseconds},
+		{input: "1631357757780123", expected: time.Date(2021, 9, 11, 10, 55, 57, 780123000, utc), expectedLayout: LayoutUnixMicroseconds},
+		{input: "1631357757780123456", expected: time.Date(2021, 9, 11, 10, 55, 57, 780123456, utc), expectedLayout: LayoutUnixNanoseconds},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, layout, err := ParseAnyWithOptions(table.input, ParseOptions{Location: utc})
+
+		// THEN
+		assert.Nil(err, table.input)
+		assert.True(table.expected.Equal(actual), table.input)
+		assert.Equal(table.expectedLayout, layout, table.input)
+	}
+}
+
+func TestParseAny_LocalLocation_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	actual, layout, err := ParseAny("2021-09-11 10:55:57")
+
+	// THEN
+	assert.Nil(err)
+	assert.Equal(YYYY_MM_DD_HH_MM_SS, layout)
+	assert.Equal(time.Date(2021, 9, 11, 10, 55, 57, 0, time.Local), actual)
+}
+
+func TestParseAny_InvalidInput_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		input       string
+		expectedErr string
+	}{
+		{input: "", expectedErr: "Unknown format of datetime \"\""},
+		{input: "11.09.2021", expectedErr: "Unknown format of datetime \"11.09.2021\""},
+		{input: "32-01-2021", expectedErr: "Unknown format of datetime \"32-01-2021\""},
+		{input: "12345", expectedErr: "Unknown format of datetime \"12345\""},
+		{input: "01-02-2026", expectedErr: "Ambiguous datetime \"01-02-2026\" matches both \"02-01-2006\" and \"01-02-2006\", set the date order to choose one"},
+		{input: "01/02/2026 10:00:00", expectedErr: "Ambiguous datetime \"01/02/2026 10:00:00\" matches both \"02/01/2006 15:04:05\" and \"01/02/2006 15:04:05\", set the date order to choose one"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		_, _, err := ParseAny(table.input)
+
+		// THEN
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+}
+
+func TestParseAnyWithOptions_DateOrder_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+
+	// WHEN
+	dayFirst, dayFirstLayout, errDayFirst := ParseAnyWithOptions("01-02-2026", ParseOptions{DateOrder: DateOrderDayFirst, Location: hcm})
+	monthFirst, monthFirstLayout, errMonthFirst := ParseAnyWithOptions("01-02-2026", ParseOptions{DateOrder: DateOrderMonthFirst, Location: hcm})
+
+	// THEN
+	assert.Nil(errDayFirst)
+	assert.Nil(errMonthFirst)
+	assert.Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, hcm), dayFirst)
+	assert.Equal(DD_MM_YYYY, dayFirstLayout)
+	assert.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, hcm), monthFirst)
+	assert.Equal(MM_DD_YYYY, monthFirstLayout)
+}
+
+func TestParseAnyWithOptions_CustomLayouts_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	layouts := []string{"20060102", "2006/01/02", LayoutUnixMilliseconds}
+
+	// WHEN
+	compact, compactLayout, errCompact := ParseAnyWithOptions("20210911", ParseOptions{Layouts: layouts, Location: time.UTC})
+	millis, millisLayout, errMillis := ParseAnyWithOptions("1631357757780", ParseOptions{Layouts: layouts, Location: time.UTC})
+	_, _, errSeconds := ParseAnyWithOptions("1631357757", ParseOptions{Layouts: layouts})
+	_, _, errDefault := ParseAnyWithOptions("2021-09-11", ParseOptions{Layouts: layouts})
+
+	// THEN
+	assert.Nil(errCompact)
+	assert.Nil(errMillis)
+	assert.NotNil(errSeconds)
+	assert.NotNil(errDefault)
+	assert.Equal(time.Date(2021, 9, 11, 0, 0, 0, 0, time.UTC), compact)
+	assert.Equal("20060102", compactLayout)
+	assert.Equal(time.Date(2021, 9, 11, 10, 55, 57, 780000000, time.UTC), millis)
+	assert.Equal(LayoutUnixMilliseconds, millisLayout)
+	assert.Equal(defaultParseLayouts, DefaultParseLayouts())
+}
+
+func BenchmarkParseAny(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, _ = ParseAny("25-09-2021 10:55:57")
+	}
+}
@@ -0,0 +1,325 @@
+package datetime
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+var layoutCache sync.Map
+
+// javaTokens maps the Java letter and its count to the Go layout. The count 0 is used for any other count.
+var javaTokens = map[byte]map[int]string{
+	'y': {1: "2006", 2: "06", 3: "2006", 0: "2006"},
+	'u': {1: "2006", 2: "06", 3: "2006", 0: "2006"},
+	'M': {1: "1", 2: "01", 3: "Jan", 0: "January"},
+	'd': {1: "2", 2: "02"},
+	'D': {3: "002"},
+	'H': {2: "15"},
+	'h': {1: "3", 2: "03"},
+	'm': {1: "4", 2: "04"},
+	's': {1: "5", 2: "05"},
+	'a': {1: "PM"},
+	'E': {1: "Mon", 2: "Mon", 3: "Mon", 0: "Monday"},
+	'z': {1: "MST", 2: "MST", 3: "MST"},
+	'Z': {1: "-0700", 2: "-0700", 3: "-0700", 5: "-07:00"},
+	'X': {1: "Z07", 2: "Z0700", 3: "Z07:00"},
+	'x': {1: "-07", 2: "-0700", 3: "-07:00"},
+}
+
+var strftimeTokens = map[string]string{
+	"Y":  "2006",
+	"y":  "06",
+	"m":  "01",
+	"-m": "1",
+	"d":  "02",
+	"-d": "2",
+	"e":  "_2",
+	"j":  "002",
+	"H":  "15",
+	"I":  "03",
+	"-I": "3",
+	"M":  "04",
+	"-M": "4",
+	"S":  "05",
+	"-S": "5",
+	"p":  "PM",
+	"b":  "Jan",
+	"h":  "Jan",
+	"B":  "January",
+	"a":  "Mon",
+	"A":  "Monday",
+	"Z":  "MST",
+	"z":  "-0700",
+	":z": "-07:00",
+	"F":  "2006-01-02",
+	"D":  "01/02/06",
+	"T":  "15:04:05",
+	"R":  "15:04",
+}
+
+// PatternToLayout translates the Java pattern such as "yyyy-MM-dd HH:mm:ss.SSS"
+// or the strftime pattern such as "%Y-%m-%d %H:%M:%S" into the Go layout.
+// The pattern containing "%" is strftime, otherwise it is Java. The layouts are cached.
+//
+// Java supports the letters y, u, M, d, DDD, HH, h, m, s, S (after "."), a, E, z, Z, X, x and the quoted text 'T'.
+// strftime supports %Y, %y, %m, %d, %e, %j, %H, %I, %M, %S, %f (after "."), %p, %b, %h, %B, %a, %A, %Z, %z, %:z, %F, %D, %T, %R, %%
+// and the "-" flag of %m, %d, %I, %M and %S.
+// The other tokens, and the text that Go would read as a layout element such as digits or "Jan", are rejected.
+func PatternToLayout(pattern string) (string, error) {
+	if layout, ok := layoutCache.Load(pattern); ok {
+		return layout.(string), nil
+	}
+
+	var layout string
+	var err error
+	if strings.Contains(pattern, "%") {
+		layout, err = strftimeToLayout(pattern)
+	} else {
+		layout, err = javaToLayout(pattern)
+	}
+	if err != nil {
+		return "", ero.Wrap(err).AddContextf("Invalid pattern %q", pattern)
+	}
+
+	layoutCache.Store(pattern, layout)
+	return layout, nil
+}
+
+// FormatPattern formats t with the Java or strftime pattern.
+func FormatPattern(t time.Time, pattern string) (string, error) {
+	layout, err := PatternToLayout(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	return t.Format(layout), nil
+}
+
+// ParsePattern parses the value with the Java or strftime pattern to the local time.
+func ParsePattern(value, pattern string) (time.Time, error) {
+	return ParsePatternInLocation(value, pattern, time.Local)
+}
+
+// ParsePatternInLocation parses the value with the Java or strftime pattern to the time in the location.
+func ParsePatternInLocation(value, pattern string, loc *time.Location) (time.Time, error) {
+	layout, err := PatternToLayout(pattern)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.ParseInLocation(layout, value, loc)
+}
+
+func javaToLayout(pattern string) (string, error) {
+	b := &layoutBuilder{}
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			literal, next, err := javaQuoted(pattern, i)
+			if err != nil {
+				return "", err
+			}
+			if err := b.literal(literal); err != nil {
+				return "", err
+			}
+			i = next
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			count := 1
+			for i+count < len(pattern) && pattern[i+count] == c {
+				count++
+			}
+			if err := b.javaToken(c, count); err != nil {
+				return "", err
+			}
+			i += count
+		default:
+			if err := b.literal(string(c)); err != nil {
+				return "", err
+			}
+			i++
+		}
+	}
+
+	return b.layout()
+}
+
+// javaQuoted returns the text quoted from pattern[start] and the index after the closing quote.
+// Two quotes '' are a single quote.
+func javaQuoted(pattern string, start int) (string, int, error) {
+	if start+1 < len(pattern) && pattern[start+1] == '\'' {
+		return "'", start + 2, nil
+	}
+
+	var literal strings.Builder
+	for i := start + 1; i < len(pattern); i++ {
+		if pattern[i] != '\'' {
+			literal.WriteByte(pattern[i])
+			continue
+		}
+		if i+1 < len(pattern) && pattern[i+1] == '\'' {
+			literal.WriteByte('\'')
+			i++
+			continue
+		}
+
+		return literal.String(), i + 1, nil
+	}
+
+	return "", 0, ero.New("Unterminated quote")
+}
+
+func strftimeToLayout(pattern string) (string, error) {
+	b := &layoutBuilder{}
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' {
+			if err := b.literal(string(pattern[i])); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		if i+1 >= len(pattern) {
+			return "", ero.New("Pattern ends with %")
+		}
+
+		token := pattern[i+1 : i+2]
+		if (token == "-" || token == ":") && i+2 < len(pattern) {
+			token = pattern[i+1 : i+3]
+		}
+		i += len(token)
+
+		switch token {
+		case "%":
+			if err := b.literal("%"); err != nil {
+				return "", err
+			}
+		case "f":
+			// Python %f is microseconds.
+			if err := b.fraction(6); err != nil {
+				return "", err
+			}
+		default:
+			layout, ok := strftimeTokens[token]
+			if !ok {
+				return "", ero.Newf("Unsupported token %%%s", token)
+			}
+			if err := b.element(layout); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return b.layout()
+}
+
+// layoutElements are the layout elements made of letters, which a literal can form alone
+// or with the adjacent element, e.g. the literal "day" after "Mon".
+var layoutElements = []string{"January", "Jan", "Monday", "Mon", "MST", "PM", "pm"}
+
+type layoutBuilder struct {
+	buf []byte
+	// literals are the ranges [start, end) of the literal text in buf.
+	literals [][2]int
+}
+
+// layout returns the Go layout, or an error if a literal forms a layout element.
+// It is checked on the whole layout because the pattern passes the literal text in pieces.
+func (b *layoutBuilder) layout() (string, error) {
+	layout := string(b.buf)
+	for _, element := range layoutElements {
+		for offset := 0; ; {
+			index := strings.Index(layout[offset:], element)
+			if index < 0 {
+				break
+			}
+			start := offset + index
+			end := start + len(element)
+			for _, literal := range b.literals {
+				if start >= literal[1] || end <= literal[0] {
+					continue
+				}
+				text := layout[literal[0]:literal[1]]
+				if start >= literal[0] && end <= literal[1] {
+					return "", ero.Newf("Literal %q contains %q which Go layout cannot represent", text, element)
+				}
+				return "", ero.Newf("Literal %q forms %q with the adjacent element which Go layout cannot represent", text, element)
+			}
+			offset = start + 1
+		}
+	}
+
+	return layout, nil
+}
+
+func (b *layoutBuilder) javaToken(letter byte, count int) error {
+	if letter == 'S' {
+		return b.fraction(count)
+	}
+
+	token := strings.Repeat(string(letter), count)
+	counts, ok := javaTokens[letter]
+	if !ok {
+		return ero.Newf("Unsupported token %s", token)
+	}
+
+	layout, ok := counts[count]
+	if !ok && count > 3 {
+		layout, ok = counts[0]
+	}
+	if !ok {
+		return ero.Newf("Unsupported token %s", token)
+	}
+
+	return b.element(layout)
+}
+
+// element appends the layout element.
+func (b *layoutBuilder) element(layout string) error {
+	// Go reads "_2" as the day padded with space and "__2" as the day of year.
+	if len(b.buf) > 0 && b.buf[len(b.buf)-1] == '_' && (layout == "2" || layout == "_2") {
+		return ero.New("Day cannot follow \"_\"")
+	}
+
+	b.buf = append(b.buf, layout...)
+	return nil
+}
+
+// fraction appends the fractional seconds with the digits, Go requires them to follow ".".
+func (b *layoutBuilder) fraction(digits int) error {
+	if digits > 9 {
+		return ero.Newf("Fractional seconds support at most 9 digits but got %d", digits)
+	}
+	if len(b.buf) == 0 || b.buf[len(b.buf)-1] != '.' {
+		return ero.New("Fractional seconds must follow \".\"")
+	}
+
+	b.buf = append(b.buf, strings.Repeat("0", digits)...)
+	return nil
+}
+
+// literal appends the text if Go does not read it as a layout element.
+// The text passed in pieces, such as strftime literals, and the text next to an element are checked by layout.
+func (b *layoutBuilder) literal(text string) error {
+	if strings.ContainsAny(text, "0123456789") {
+		return ero.Newf("Literal %q contains digits which Go layout cannot represent", text)
+	}
+	for _, element := range layoutElements {
+		if strings.Contains(text, element) {
+			return ero.Newf("Literal %q contains %q which Go layout cannot represent", text, element)
+		}
+	}
+
+	start := len(b.buf)
+	b.buf = append(b.buf, text...)
+	if last := len(b.literals) - 1; last >= 0 && b.literals[last][1] == start {
+		b.literals[last][1] = len(b.buf)
+	} else {
+		b.literals = append(b.literals, [2]int{start, len(b.buf)})
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatternToLayout_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		pattern  string
+		expected string
+	}{
+		{pattern: "yyyy-MM-dd HH:mm:ss.SSS", expected: YYYY_MM_DD_HH_MM_SS_SSS},
+		{pattern: "dd-MM-yyyy", expected: DD_MM_YYYY},
+		{pattern: "yyyy-MM-dd'T'HH:mm:ssXXX", expected: "2006-01-02T15:04:05Z07:00"},
+		{pattern: "EEE, d MMM yy h:mm a z", expected: "Mon, 2 Jan 06 3:04 PM MST"},
+		{pattern: "EEEE, MMMM dd, yyyy", expected: "Monday, January 02, 2006"},
+		{pattern: "yyyyMMddHHmmss", expected: "20060102150405"},
+		{pattern: "yyyy.DDD 'o''clock' Z", expected: "2006.002 o'clock -0700"},
+		{pattern: "''yy''", expected: "'06'"},
+		{pattern: "%Y-%m-%d %H:%M:%S.%f", expected: "2006-01-02 15:04:05.000000"},
+		{pattern: "%d/%m/%Y", expected: "02/01/2006"},
+		{pattern: "%a, %-d %b %Y %I:%M %p %z", expected: "Mon, 2 Jan 2006 03:04 PM -0700"},
+		{pattern: "%FT%T%:z", expected: "2006-01-02T15:04:05-07:00"},
+		{pattern: "%e %B %j %%", expected: "_2 January 002 %"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := PatternToLayout(table.pattern)
+
+		// THEN
+		assert.Nil(err, table.pattern)
+		assert.Equal(table.expected, actual, table.pattern)
+	}
+}
+
+func TestPatternToLayout_UnsupportedToken_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		pattern     string
+		expectedErr string
+	}{
+		{pattern: "yyyy-MM-dd G", expectedErr: "Invalid pattern \"yyyy-MM-dd G\": Unsupported token G"},
+		{pattern: "H:mm", expectedErr: "Invalid pattern \"H:mm\": Unsupported token H"},
+		{pattern: "ddd", expectedErr: "Invalid pattern \"ddd\": Unsupported token ddd"},
+		{pattern: "zzzz", expectedErr: "Invalid pattern \"zzzz\": Unsupported token zzzz"},
+		{pattern: "HH:mm:ssSSS", expectedErr: "Invalid pattern \"HH:mm:ssSSS\": Fractional seconds must follow \".\""},
+		{pattern: "ss.SSSSSSSSSS", expectedErr: "Invalid pattern \"ss.SSSSSSSSSS\": Fractional seconds support at most 9 digits but got 10"},
+		{pattern: "yyyy 'Q1'", expectedErr: "Invalid pattern \"yyyy 'Q1'\": Literal \"Q1\" contains digits which Go layout cannot represent"},
+		{pattern: "'Month' MM", expectedErr: "Invalid pattern \"'Month' MM\": Literal \"Month\" contains \"Mon\" which Go layout cannot represent"},
+		{pattern: "yyyy_d", expectedErr: "Invalid pattern \"yyyy_d\": Day cannot follow \"_\""},
+		{pattern: "yyyy-MM-dd 'T", expectedErr: "Invalid pattern \"yyyy-MM-dd 'T\": Unterminated quote"},
+		{pattern: "%Y-%U", expectedErr: "Invalid pattern \"%Y-%U\": Unsupported token %U"},
+		{pattern: "%s", expectedErr: "Invalid pattern \"%s\": Unsupported token %s"},
+		{pattern: "%Y%", expectedErr: "Invalid pattern \"%Y%\": Pattern ends with %"},
+		{pattern: "%H:%M 1", expectedErr: "Invalid pattern \"%H:%M 1\": Literal \"1\" contains digits which Go layout cannot represent"},
+		{pattern: "Mon %Y", expectedErr: "Invalid pattern \"Mon %Y\": Literal \"Mon \" contains \"Mon\" which Go layout cannot represent"},
+		{pattern: "Jan %d", expectedErr: "Invalid pattern \"Jan %d\": Literal \"Jan \" contains \"Jan\" which Go layout cannot represent"},
+		{pattern: "MST %Y", expectedErr: "Invalid pattern \"MST %Y\": Literal \"MST \" contains \"MST\" which Go layout cannot represent"},
+		{pattern: "%I:%M PM", expectedErr: "Invalid pattern \"%I:%M PM\": Literal \" PM\" contains \"PM\" which Go layout cannot represent"},
+		{pattern: "%aday", expectedErr: "Invalid pattern \"%aday\": Literal \"day\" forms \"Monday\" with the adjacent element which Go layout cannot represent"},
+		{pattern: "EEE'day'", expectedErr: "Invalid pattern \"EEE'day'\": Literal \"day\" forms \"Monday\" with the adjacent element which Go layout cannot represent"},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		_, err := PatternToLayout(table.pattern)
+
+		// THEN
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+	}
+}
+
+func TestFormatAndParsePattern_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+	input := time.Date(2021, 9, 11, 17, 55, 57, 780000000, hcm)
+
+	// WHEN
+	java, errJava := FormatPattern(input, "yyyy-MM-dd HH:mm:ss.SSS")
+	strftime, errStrftime := FormatPattern(input, "%d/%m/%Y %I:%M %p")
+	parsedJava, errParsedJava := ParsePatternInLocation("11-09-2021 17:55:57", "dd-MM-yyyy HH:mm:ss", hcm)
+	parsedStrftime, errParsedStrftime := ParsePattern("2021-09-11T17:55:57.780000+07:00", "%Y-%m-%dT%H:%M:%S.%f%:z")
+	_, errUnsupported := FormatPattern(input, "yyyy-ww")
+	_, errParseUnsupported := ParsePattern("2021-37", "yyyy-ww")
+	_, errMismatch := ParsePattern("2021/09/11", "yyyy-MM-dd")
+	_, errLiteral := FormatPattern(input, "Mon %Y")
+	month, errMonth := FormatPattern(input, "%a, %d of %B")
+
+	// THEN
+	assert.Nil(errJava)
+	assert.Nil(errStrftime)
+	assert.Nil(errParsedJava)
+	assert.Nil(errParsedStrftime)
+	assert.Equal("2021-09-11 17:55:57.780", java)
+	assert.Equal("11/09/2021 05:55 PM", strftime)
+	assert.Equal(time.Date(2021, 9, 11, 17, 55, 57, 0, hcm), parsedJava)
+	assert.True(input.Equal(parsedStrftime))
+	assert.NotNil(errUnsupported)
+	assert.NotNil(errParseUnsupported)
+	assert.NotNil(errMismatch)
+	assert.NotNil(errLiteral)
+	assert.Nil(errMonth)
+	assert.Equal("Sat, 11 of September", month)
+}
+
+func BenchmarkFormatPattern(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	now := time.Now()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = FormatPattern(now, "yyyy-MM-dd HH:mm:ss.SSS")
+	}
+}
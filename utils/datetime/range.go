@@ -0,0 +1,200 @@
+package datetime
+
+import (
+	"sort"
+	"time"
+
+	ero "github.com/phamtai97/go-utils/utils/error"
+)
+
+// Period is the calendar unit to split a range.
+type Period int
+
+const (
+	// PeriodHour splits at the start of every hour.
+	PeriodHour Period = iota
+	// PeriodDay splits at the start of every day.
+	PeriodDay
+	// PeriodMonth splits at the start of every month.
+	PeriodMonth
+)
+
+// Range is the half-open time range [Start, End).
+//
+// The location of Start is the timezone of range, it is used to find the start of hours, days and months when splitting,
+// and the results of Intersect, Union and MergeRanges are in the location of the first range.
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewRange returns the range [start, end). It returns an error if end is before start.
+func NewRange(start, end time.Time) (Range, error) {
+	if end.Before(start) {
+		return Range{}, ero.Newf("End %s is before start %s", end.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano))
+	}
+
+	return Range{Start: start, End: end}, nil
+}
+
+// String returns the range in the form "[start, end)" with RFC 3339 times.
+func (r Range) String() string {
+	return "[" + r.Start.Format(time.RFC3339Nano) + ", " + r.End.Format(time.RFC3339Nano) + ")"
+}
+
+// IsEmpty reports whether the range has no instant.
+func (r Range) IsEmpty() bool {
+	return !r.Start.Before(r.End)
+}
+
+// Duration returns the duration of range. It is 0 if the range is empty.
+func (r Range) Duration() time.Duration {
+	if r.IsEmpty() {
+		return 0
+	}
+
+	return r.End.Sub(r.Start)
+}
+
+// Contains reports whether t is in the range, Start is included and End is excluded.
+func (r Range) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// Overlaps reports whether the ranges have at least one common instant.
+func (r Range) Overlaps(other Range) bool {
+	return !r.IsEmpty() && !other.IsEmpty() && r.Start.Before(other.End) && other.Start.Before(r.End)
+}
+
+// Intersect returns the common part of the ranges in the location of r.
+// It returns false if the ranges do not overlap.
+func (r Range) Intersect(other Range) (Range, bool) {
+	if !r.Overlaps(other) {
+		return Range{}, false
+	}
+
+	loc := r.Start.Location()
+	return Range{Start: maxTime(r.Start, other.Start).In(loc), End: minTime(r.End, other.End).In(loc)}, true
+}
+
+// Union returns the range covering both ranges in the location of r.
+// It returns false if the ranges neither overlap nor touch, because the union is not a single range.
+func (r Range) Union(other Range) (Range, bool) {
+	switch {
+	case other.IsEmpty():
+		return r, true
+	case r.IsEmpty():
+		return Range{Start: other.Start.In(r.Start.Location()), End: other.End.In(r.Start.Location())}, true
+	case r.Start.After(other.End) || other.Start.After(r.End):
+		return Range{}, false
+	}
+
+	loc := r.Start.Location()
+	return Range{Start: minTime(r.Start, other.Start).In(loc), End: maxTime(r.End, other.End).In(loc)}, true
+}
+
+// Split splits the range at the start of every hour, day or month in the location of Start.
+// The first and the last parts may be shorter than the period.
+func (r Range) Split(period Period) []Range {
+	var ranges []Range
+	for it := r.Iterate(period); it.Next(); {
+		ranges = append(ranges, it.Range())
+	}
+
+	return ranges
+}
+
+// Iterate returns the iterator over the parts of Split without allocating all of them, e.g.
+//
+// 	for it := r.Iterate(datetime.PeriodDay); it.Next(); {
+// 		day := it.Range()
+// 		...
+// 	}
+func (r Range) Iterate(period Period) *RangeIterator {
+	return &RangeIterator{period: period, next: r.Start, end: r.End}
+}
+
+// RangeIterator iterates over the parts of a range. It is not safe for concurrent use.
+type RangeIterator struct {
+	period  Period
+	next    time.Time
+	end     time.Time
+	current Range
+}
+
+// Next moves to the next part. It returns false when there is no part left.
+func (it *RangeIterator) Next() bool {
+	if !it.next.Before(it.end) {
+		return false
+	}
+
+	boundary := nextBoundary(it.next, it.period)
+	if boundary.After(it.end) {
+		boundary = it.end
+	}
+
+	it.current = Range{Start: it.next, End: boundary}
+	it.next = boundary
+	return true
+}
+
+// Range returns the current part.
+func (it *RangeIterator) Range() Range {
+	return it.current
+}
+
+// MergeRanges merges the overlapping and touching ranges and drops the empty ranges.
+// The ranges are expected to be sorted by Start, otherwise a sorted copy is merged.
+// Each merged range is in the location of the first range merged into it.
+func MergeRanges(ranges []Range) []Range {
+	if !sort.SliceIsSorted(ranges, func(i, j int) bool { return ranges[i].Start.Before(ranges[j].Start) }) {
+		sorted := append([]Range(nil), ranges...)
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+		ranges = sorted
+	}
+
+	merged := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		if r.IsEmpty() {
+			continue
+		}
+
+		if last := len(merged) - 1; last >= 0 && !r.Start.After(merged[last].End) {
+			merged[last], _ = merged[last].Union(r)
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+// nextBoundary returns the start of the next hour, day or month after t in the location of t.
+func nextBoundary(t time.Time, period Period) time.Time {
+	switch period {
+	case PeriodHour:
+		// The wall clock is used so that the zones with offset of 30 or 45 minutes split at minute 0.
+		sinceHour := time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+		return t.Add(time.Hour - sinceHour)
+	case PeriodMonth:
+		return startOfDate(t.Year(), t.Month()+1, 1, t.Location())
+	default:
+		return startOfDate(t.Year(), t.Month(), t.Day()+1, t.Location())
+	}
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
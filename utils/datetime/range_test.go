@@ -0,0 +1,188 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func utcRange(startDay, startHour, endDay, endHour int) Range {
+	return Range{
+		Start: time.Date(2021, 9, startDay, startHour, 0, 0, 0, time.UTC),
+		End:   time.Date(2021, 9, endDay, endHour, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewRange_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	start := time.Date(2021, 9, 11, 0, 0, 0, 0, time.UTC)
+
+	// WHEN
+	r, err := NewRange(start, start.Add(time.Hour))
+	empty, errEmpty := NewRange(start, start)
+	_, errInvalid := NewRange(start, start.Add(-time.Hour))
+
+	// THEN
+	assert.Nil(err)
+	assert.Nil(errEmpty)
+	assert.Equal(time.Hour, r.Duration())
+	assert.False(r.IsEmpty())
+	assert.True(empty.IsEmpty())
+	assert.Equal(time.Duration(0), empty.Duration())
+	assert.Equal("[2021-09-11T00:00:00Z, 2021-09-11T01:00:00Z)", r.String())
+	assert.NotNil(errInvalid)
+	assert.Equal("End 2021-09-10T23:00:00Z is before start 2021-09-11T00:00:00Z", errInvalid.Error())
+}
+
+func TestRangeContainsAndOverlaps_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	r := utcRange(11, 8, 11, 17)
+
+	// WHEN
+	// THEN
+	assert.True(r.Contains(r.Start))
+	assert.True(r.Contains(r.End.Add(-time.Nanosecond)))
+	assert.False(r.Contains(r.End))
+	assert.False(r.Contains(r.Start.Add(-time.Nanosecond)))
+
+	assert.True(r.Overlaps(utcRange(11, 16, 11, 20)))
+	assert.True(r.Overlaps(utcRange(11, 10, 11, 11)))
+	assert.False(r.Overlaps(utcRange(11, 17, 11, 20)))
+	assert.False(r.Overlaps(utcRange(11, 10, 11, 10)))
+}
+
+func TestRangeIntersectAndUnion_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+	r := Range{Start: time.Date(2021, 9, 11, 15, 0, 0, 0, hcm), End: time.Date(2021, 9, 12, 0, 0, 0, 0, hcm)}
+	// 2021-09-11 08:00 - 17:00 UTC is 15:00 - 24:00 in Ho Chi Minh
+	other := utcRange(11, 12, 11, 20)
+
+	// WHEN
+	intersect, okIntersect := r.Intersect(other)
+	union, okUnion := r.Union(other)
+	touching, okTouching := other.Union(utcRange(11, 20, 11, 22))
+	_, okDisjointIntersect := other.Intersect(utcRange(11, 20, 11, 22))
+	_, okDisjointUnion := other.Union(utcRange(11, 21, 11, 22))
+
+	// THEN
+	assert.True(okIntersect)
+	assert.Equal(Range{Start: time.Date(2021, 9, 11, 19, 0, 0, 0, hcm), End: time.Date(2021, 9, 12, 0, 0, 0, 0, hcm)}, intersect)
+	assert.True(okUnion)
+	assert.Equal(Range{Start: time.Date(2021, 9, 11, 15, 0, 0, 0, hcm), End: time.Date(2021, 9, 12, 3, 0, 0, 0, hcm)}, union)
+	assert.True(okTouching)
+	assert.Equal(utcRange(11, 12, 11, 22), touching)
+	assert.False(okDisjointIntersect)
+	assert.False(okDisjointUnion)
+}
+
+func TestRangeSplit_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+	r := Range{Start: time.Date(2021, 9, 29, 22, 30, 0, 0, hcm), End: time.Date(2021, 10, 1, 1, 15, 0, 0, hcm)}
+
+	// WHEN
+	days := r.Split(PeriodDay)
+	months := r.Split(PeriodMonth)
+	hours := Range{Start: r.Start, End: time.Date(2021, 9, 30, 0, 10, 0, 0, hcm)}.Split(PeriodHour)
+	empty := Range{Start: r.Start, End: r.Start}.Split(PeriodDay)
+
+	// THEN
+	assert.Equal([]Range{
+		{Start: time.Date(2021, 9, 29, 22, 30, 0, 0, hcm), End: time.Date(2021, 9, 30, 0, 0, 0, 0, hcm)},
+		{Start: time.Date(2021, 9, 30, 0, 0, 0, 0, hcm), End: time.Date(2021, 10, 1, 0, 0, 0, 0, hcm)},
+		{Start: time.Date(2021, 10, 1, 0, 0, 0, 0, hcm), End: time.Date(2021, 10, 1, 1, 15, 0, 0, hcm)},
+	}, days)
+	assert.Equal([]Range{
+		{Start: time.Date(2021, 9, 29, 22, 30, 0, 0, hcm), End: time.Date(2021, 10, 1, 0, 0, 0, 0, hcm)},
+		{Start: time.Date(2021, 10, 1, 0, 0, 0, 0, hcm), End: time.Date(2021, 10, 1, 1, 15, 0, 0, hcm)},
+	}, months)
+	assert.Equal([]Range{
+		{Start: time.Date(2021, 9, 29, 22, 30, 0, 0, hcm), End: time.Date(2021, 9, 29, 23, 0, 0, 0, hcm)},
+		{Start: time.Date(2021, 9, 29, 23, 0, 0, 0, hcm), End: time.Date(2021, 9, 30, 0, 0, 0, 0, hcm)},
+		{Start: time.Date(2021, 9, 30, 0, 0, 0, 0, hcm), End: time.Date(2021, 9, 30, 0, 10, 0, 0, hcm)},
+	}, hours)
+	assert.Len(empty, 0)
+}
+
+func TestRangeSplit_Timezone_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	kolkata := MustLoadLocation("Asia/Kolkata")
+	newYork := MustLoadLocation("America/New_York")
+
+	// WHEN
+	// The offset of Kolkata is +05:30
+	hours := Range{Start: time.Date(2021, 9, 11, 10, 45, 0, 0, kolkata), End: time.Date(2021, 9, 11, 12, 0, 0, 0, kolkata)}.Split(PeriodHour)
+	// The day 2021-11-07 has 25 hours in New York
+	days := Range{Start: time.Date(2021, 11, 6, 12, 0, 0, 0, newYork), End: time.Date(2021, 11, 8, 12, 0, 0, 0, newYork)}.Split(PeriodDay)
+
+	// THEN
+	assert.Len(hours, 2)
+	assert.Equal(time.Date(2021, 9, 11, 11, 0, 0, 0, kolkata), hours[0].End)
+	assert.Len(days, 3)
+	assert.Equal(time.Date(2021, 11, 7, 0, 0, 0, 0, newYork), days[1].Start)
+	assert.Equal(25*time.Hour, days[1].Duration())
+}
+
+func TestRangeIterate_SimpleInput_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	r := Range{Start: time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC), End: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)}
+	var months []time.Month
+
+	// WHEN
+	for it := r.Iterate(PeriodMonth); it.Next(); {
+		months = append(months, it.Range().Start.Month())
+	}
+
+	// THEN
+	assert.Equal([]time.Month{time.January, time.February, time.March}, months)
+}
+
+func TestMergeRanges_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		input    []Range
+		expected []Range
+	}{
+		{input: nil, expected: []Range{}},
+		{
+			input:    []Range{utcRange(11, 1, 11, 3), utcRange(11, 2, 11, 4), utcRange(11, 4, 11, 5), utcRange(11, 7, 11, 8)},
+			expected: []Range{utcRange(11, 1, 11, 5), utcRange(11, 7, 11, 8)},
+		},
+		{
+			input:    []Range{utcRange(11, 1, 11, 10), utcRange(11, 2, 11, 3), utcRange(11, 6, 11, 6)},
+			expected: []Range{utcRange(11, 1, 11, 10)},
+		},
+		{
+			// Not sorted
+			input:    []Range{utcRange(12, 1, 12, 3), utcRange(11, 1, 11, 3), utcRange(11, 3, 11, 4)},
+			expected: []Range{utcRange(11, 1, 11, 4), utcRange(12, 1, 12, 3)},
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual := MergeRanges(table.input)
+
+		// THEN
+		assert.Equal(table.expected, actual)
+	}
+}
+
+func BenchmarkRangeSplitDay(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	r := Range{Start: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	for i := 0; i < b.N; i++ {
+		r.Split(PeriodDay)
+	}
+}
@@ -0,0 +1,298 @@
+package datetime
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// UTC is the IANA zone name of Coordinated Universal Time.
+	UTC = "UTC"
+	// AsiaHoChiMinh is the IANA zone name of Vietnam.
+	AsiaHoChiMinh = "Asia/Ho_Chi_Minh"
+)
+
+var locationCache sync.Map
+
+// LoadLocation returns the location with the IANA zone name such as "Asia/Ho_Chi_Minh".
+// The locations are cached so it is cheap to call it many times.
+// "" and "UTC" return UTC, "Local" returns the local location.
+func LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := locationCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	locationCache.Store(name, loc)
+	return loc, nil
+}
+
+// MustLoadLocation is like LoadLocation but panics if the zone name is invalid.
+func MustLoadLocation(name string) *time.Location {
+	loc, err := LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return loc
+}
+
+// GetCurrentTimeInLocation returns the current time in the location.
+func GetCurrentTimeInLocation(loc *time.Location) time.Time {
+	return GetCurrentTimeInLocationWithClock(DefaultClock(), loc)
+}
+
+// GetCurrentTimeInLocationWithClock returns the current time of the clock in the location.
+func GetCurrentTimeInLocationWithClock(clock Clock, loc *time.Location) time.Time {
+	return clock.Now().In(loc)
+}
+
+// GetCurrentTimeIn returns the current time in the IANA zone.
+func GetCurrentTimeIn(zone string) (time.Time, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return GetCurrentTimeInLocation(loc), nil
+}
+
+// ConvertStringToTimeInLocation converts the string with specific format to the time in the location.
+func ConvertStringToTimeInLocation(value, format string, loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation(format, value, loc)
+}
+
+// ConvertStringToTimeIn converts the string with specific format to the time in the IANA zone.
+func ConvertStringToTimeIn(value, format, zone string) (time.Time, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return ConvertStringToTimeInLocation(value, format, loc)
+}
+
+// ConvertStringToMillisecondsInLocation converts the string with specific format in the location to milliseconds.
+func ConvertStringToMillisecondsInLocation(value, format string, loc *time.Location) (int64, error) {
+	t, err := ConvertStringToTimeInLocation(value, format, loc)
+	if err != nil {
+		return -1, err
+	}
+
+	return ConvertLocalTimeToMilliseconds(t), nil
+}
+
+// ConvertStringToMillisecondsIn converts the string with specific format in the IANA zone to milliseconds.
+func ConvertStringToMillisecondsIn(value, format, zone string) (int64, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return -1, err
+	}
+
+	return ConvertStringToMillisecondsInLocation(value, format, loc)
+}
+
+// ConvertMillisecondsToTimeInLocation converts the milliseconds to the time in the location.
+func ConvertMillisecondsToTimeInLocation(millis int64, loc *time.Location) time.Time {
+	return ConvertMillisecondsToLocalTime(millis).In(loc)
+}
+
+// ConvertMillisecondsToTimeIn converts the milliseconds to the time in the IANA zone.
+func ConvertMillisecondsToTimeIn(millis int64, zone string) (time.Time, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return ConvertMillisecondsToTimeInLocation(millis, loc), nil
+}
+
+// ConvertMillisecondsToStringInLocation converts the milliseconds to the string with specific format in the location.
+func ConvertMillisecondsToStringInLocation(millis int64, format string, loc *time.Location) string {
+	return ConvertMillisecondsToTimeInLocation(millis, loc).Format(format)
+}
+
+// ConvertMillisecondsToStringIn converts the milliseconds to the string with specific format in the IANA zone.
+func ConvertMillisecondsToStringIn(millis int64, format, zone string) (string, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return "", err
+	}
+
+	return ConvertMillisecondsToStringInLocation(millis, format, loc), nil
+}
+
+// ConvertTimeToStringInLocation converts the time to the string with specific format in the location.
+func ConvertTimeToStringInLocation(t time.Time, format string, loc *time.Location) string {
+	return t.In(loc).Format(format)
+}
+
+// ConvertTimeToStringIn converts the time to the string with specific format in the IANA zone.
+func ConvertTimeToStringIn(t time.Time, format, zone string) (string, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return "", err
+	}
+
+	return ConvertTimeToStringInLocation(t, format, loc), nil
+}
+
+// StartOfDay returns the start time of day of t in the location of t.
+func StartOfDay(t time.Time) time.Time {
+	return startOfDate(t.Year(), t.Month(), t.Day(), t.Location())
+}
+
+// StartOfDayInLocation returns the start time of day of t in the location.
+// If midnight does not exist because of DST, it returns the first instant of the day.
+func StartOfDayInLocation(t time.Time, loc *time.Location) time.Time {
+	return StartOfDay(t.In(loc))
+}
+
+// StartOfDayIn returns the start time of day of t in the IANA zone.
+func StartOfDayIn(t time.Time, zone string) (time.Time, error) {
+	return inZone(t, zone, StartOfDayInLocation)
+}
+
+// EndOfDay returns the end time of day of t in the location of t.
+func EndOfDay(t time.Time) time.Time {
+	return startOfDate(t.Year(), t.Month(), t.Day()+1, t.Location()).Add(-time.Nanosecond)
+}
+
+// EndOfDayInLocation returns the end time of day of t in the location.
+// It is the last nanosecond before the start of the next day, so days of 23 or 25 hours because of DST are correct.
+func EndOfDayInLocation(t time.Time, loc *time.Location) time.Time {
+	return EndOfDay(t.In(loc))
+}
+
+// EndOfDayIn returns the end time of day of t in the IANA zone.
+func EndOfDayIn(t time.Time, zone string) (time.Time, error) {
+	return inZone(t, zone, EndOfDayInLocation)
+}
+
+// StartOfMonth returns the start time of month of t in the location of t.
+func StartOfMonth(t time.Time) time.Time {
+	return startOfDate(t.Year(), t.Month(), 1, t.Location())
+}
+
+// StartOfMonthInLocation returns the start time of month of t in the location.
+func StartOfMonthInLocation(t time.Time, loc *time.Location) time.Time {
+	return StartOfMonth(t.In(loc))
+}
+
+// StartOfMonthIn returns the start time of month of t in the IANA zone.
+func StartOfMonthIn(t time.Time, zone string) (time.Time, error) {
+	return inZone(t, zone, StartOfMonthInLocation)
+}
+
+// EndOfMonth returns the end time of month of t in the location of t.
+func EndOfMonth(t time.Time) time.Time {
+	return startOfDate(t.Year(), t.Month()+1, 1, t.Location()).Add(-time.Nanosecond)
+}
+
+// EndOfMonthInLocation returns the end time of month of t in the location.
+func EndOfMonthInLocation(t time.Time, loc *time.Location) time.Time {
+	return EndOfMonth(t.In(loc))
+}
+
+// EndOfMonthIn returns the end time of month of t in the IANA zone.
+func EndOfMonthIn(t time.Time, zone string) (time.Time, error) {
+	return inZone(t, zone, EndOfMonthInLocation)
+}
+
+// StartOfYear returns the start time of year of t in the location of t.
+func StartOfYear(t time.Time) time.Time {
+	return startOfDate(t.Year(), time.January, 1, t.Location())
+}
+
+// StartOfYearInLocation returns the start time of year of t in the location.
+func StartOfYearInLocation(t time.Time, loc *time.Location) time.Time {
+	return StartOfYear(t.In(loc))
+}
+
+// StartOfYearIn returns the start time of year of t in the IANA zone.
+func StartOfYearIn(t time.Time, zone string) (time.Time, error) {
+	return inZone(t, zone, StartOfYearInLocation)
+}
+
+// EndOfYear returns the end time of year of t in the location of t.
+func EndOfYear(t time.Time) time.Time {
+	return startOfDate(t.Year()+1, time.January, 1, t.Location()).Add(-time.Nanosecond)
+}
+
+// EndOfYearInLocation returns the end time of year of t in the location.
+func EndOfYearInLocation(t time.Time, loc *time.Location) time.Time {
+	return EndOfYear(t.In(loc))
+}
+
+// EndOfYearIn returns the end time of year of t in the IANA zone.
+func EndOfYearIn(t time.Time, zone string) (time.Time, error) {
+	return inZone(t, zone, EndOfYearInLocation)
+}
+
+// GetBeforeTimeOfTimeInLocation returns before time in the location with the number of days compared to t.
+func GetBeforeTimeOfTimeInLocation(t time.Time, numberDay int, isStartTime bool, loc *time.Location) time.Time {
+	return GetAfterTimeOfTimeInLocation(t, -numberDay, isStartTime, loc)
+}
+
+// GetBeforeTimeOfTimeIn returns before time in the IANA zone with the number of days compared to t.
+func GetBeforeTimeOfTimeIn(t time.Time, numberDay int, isStartTime bool, zone string) (time.Time, error) {
+	return GetAfterTimeOfTimeIn(t, -numberDay, isStartTime, zone)
+}
+
+// GetAfterTimeOfTimeInLocation returns after time in the location with the number of days compared to t.
+func GetAfterTimeOfTimeInLocation(t time.Time, numberDay int, isStartTime bool, loc *time.Location) time.Time {
+	t = t.In(loc)
+	day := time.Date(t.Year(), t.Month(), t.Day()+numberDay, 12, 0, 0, 0, loc)
+	if isStartTime {
+		return StartOfDay(day)
+	}
+
+	return EndOfDay(day)
+}
+
+// GetAfterTimeOfTimeIn returns after time in the IANA zone with the number of days compared to t.
+func GetAfterTimeOfTimeIn(t time.Time, numberDay int, isStartTime bool, zone string) (time.Time, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return GetAfterTimeOfTimeInLocation(t, numberDay, isStartTime, loc), nil
+}
+
+func inZone(t time.Time, zone string, fn func(time.Time, *time.Location) time.Time) (time.Time, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return fn(t, loc), nil
+}
+
+// startOfDate returns the first instant of the date in the location.
+// time.Date may return a time of the previous day when midnight is skipped by DST,
+// so the first instant is searched from there.
+func startOfDate(year int, month time.Month, day int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	year, month, day = time.Date(year, month, day, 12, 0, 0, 0, loc).Date()
+	if y, m, d := t.Date(); y == year && m == month && d == day {
+		return t
+	}
+
+	// Binary search the first instant in (t, t + 1 day] that belongs to the date.
+	low, high := t, t.Add(24*time.Hour)
+	for high.Sub(low) > time.Nanosecond {
+		mid := low.Add(high.Sub(low) / 2)
+		if y, m, d := mid.Date(); y == year && m == month && d == day {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	return high
+}
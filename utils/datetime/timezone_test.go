@@ -0,0 +1,179 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadLocation_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	loc, err := LoadLocation(AsiaHoChiMinh)
+	locCached, errCached := LoadLocation(AsiaHoChiMinh)
+	locInvalid, errInvalid := LoadLocation("Asia/Not_Exist")
+
+	// THEN
+	assert.Nil(err)
+	assert.Nil(errCached)
+	assert.Equal(AsiaHoChiMinh, loc.String())
+	assert.True(loc == locCached)
+	assert.NotNil(errInvalid)
+	assert.Nil(locInvalid)
+	assert.Panics(func() {
+		MustLoadLocation("Asia/Not_Exist")
+	})
+}
+
+func TestConvertIn_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	millis := int64(1631357757780)
+
+	// WHEN
+	strHCM, errHCM := ConvertMillisecondsToStringIn(millis, YYYY_MM_DD_HH_MM_SS_SSS, AsiaHoChiMinh)
+	strUTC, errUTC := ConvertMillisecondsToStringIn(millis, YYYY_MM_DD_HH_MM_SS_SSS, UTC)
+	millisHCM, errParseHCM := ConvertStringToMillisecondsIn("2021-09-11 17:55:57.780", YYYY_MM_DD_HH_MM_SS_SSS, AsiaHoChiMinh)
+	millisUTC, errParseUTC := ConvertStringToMillisecondsIn("2021-09-11 10:55:57.780", YYYY_MM_DD_HH_MM_SS_SSS, UTC)
+	timeHCM, errTimeHCM := ConvertStringToTimeIn("11-09-2021", DD_MM_YYYY, AsiaHoChiMinh)
+	timeFromMillis, errTimeFromMillis := ConvertMillisecondsToTimeIn(millis, AsiaHoChiMinh)
+	strFromTime, errStrFromTime := ConvertTimeToStringIn(time.Date(2021, 9, 11, 10, 55, 57, 0, time.UTC), YYYY_MM_DD_HH_MM_SS, AsiaHoChiMinh)
+	_, errInvalidZone := ConvertStringToMillisecondsIn("2021-09-11", YYYY_MM_DD, "Asia/Not_Exist")
+	_, errInvalidFormat := ConvertStringToMillisecondsIn("11-09-2021", YYYY_MM_DD, AsiaHoChiMinh)
+
+	// THEN
+	assert.Nil(errHCM)
+	assert.Nil(errUTC)
+	assert.Nil(errParseHCM)
+	assert.Nil(errParseUTC)
+	assert.Nil(errTimeHCM)
+	assert.Nil(errTimeFromMillis)
+	assert.Nil(errStrFromTime)
+	assert.Equal("2021-09-11 17:55:57.780", strHCM)
+	assert.Equal("2021-09-11 10:55:57.780", strUTC)
+	assert.Equal(millis, millisHCM)
+	assert.Equal(millis, millisUTC)
+	assert.Equal(int64(1631293200000), ConvertLocalTimeToMilliseconds(timeHCM))
+	assert.Equal(17, timeFromMillis.Hour())
+	assert.Equal("2021-09-11 17:55:57", strFromTime)
+	assert.NotNil(errInvalidZone)
+	assert.NotNil(errInvalidFormat)
+}
+
+func TestStartAndEndIn_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+	// 2021-09-11 20:00:00 UTC is 2021-09-12 03:00:00 in Ho Chi Minh
+	input := time.Date(2021, 9, 11, 20, 0, 0, 0, time.UTC)
+	tables := []struct {
+		fn       func(time.Time, string) (time.Time, error)
+		expected time.Time
+	}{
+		{fn: StartOfDayIn, expected: time.Date(2021, 9, 12, 0, 0, 0, 0, hcm)},
+		{fn: EndOfDayIn, expected: time.Date(2021, 9, 12, 23, 59, 59, 999999999, hcm)},
+		{fn: StartOfMonthIn, expected: time.Date(2021, 9, 1, 0, 0, 0, 0, hcm)},
+		{fn: EndOfMonthIn, expected: time.Date(2021, 9, 30, 23, 59, 59, 999999999, hcm)},
+		{fn: StartOfYearIn, expected: time.Date(2021, 1, 1, 0, 0, 0, 0, hcm)},
+		{fn: EndOfYearIn, expected: time.Date(2021, 12, 31, 23, 59, 59, 999999999, hcm)},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		actual, err := table.fn(input, AsiaHoChiMinh)
+		_, errInvalid := table.fn(input, "Asia/Not_Exist")
+
+		// THEN
+		assert.Nil(err)
+		assert.NotNil(errInvalid)
+		assert.Equal(table.expected, actual)
+		assert.Equal(hcm, actual.Location())
+	}
+
+	assert.Equal(time.Date(2021, 9, 11, 0, 0, 0, 0, time.UTC), StartOfDay(input))
+	assert.Equal(time.Date(2021, 9, 11, 23, 59, 59, 999999999, time.UTC), EndOfDay(input))
+	assert.Equal(time.Date(2021, 9, 12, 0, 0, 0, 0, hcm), StartOfDayInLocation(input, hcm))
+}
+
+func TestStartAndEndOfDayIn_DSTTransition_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	newYork := MustLoadLocation("America/New_York")
+	saoPaulo := MustLoadLocation("America/Sao_Paulo")
+
+	// WHEN
+	// Spring forward at 02:00, the day has 23 hours
+	startSpring := StartOfDayInLocation(time.Date(2021, 3, 14, 12, 0, 0, 0, newYork), newYork)
+	endSpring := EndOfDayInLocation(time.Date(2021, 3, 14, 12, 0, 0, 0, newYork), newYork)
+	// Fall back at 02:00, the day has 25 hours
+	startFall := StartOfDayInLocation(time.Date(2021, 11, 7, 12, 0, 0, 0, newYork), newYork)
+	endFall := EndOfDayInLocation(time.Date(2021, 11, 7, 12, 0, 0, 0, newYork), newYork)
+	// Midnight does not exist, the day starts at 01:00
+	startGap, errGap := StartOfDayIn(time.Date(2018, 11, 4, 12, 0, 0, 0, saoPaulo), "America/Sao_Paulo")
+	endBeforeGap := EndOfDayInLocation(time.Date(2018, 11, 3, 12, 0, 0, 0, saoPaulo), saoPaulo)
+
+	// THEN
+	assert.Equal(23*time.Hour-time.Nanosecond, endSpring.Sub(startSpring))
+	assert.Equal(25*time.Hour-time.Nanosecond, endFall.Sub(startFall))
+	assert.Nil(errGap)
+	assert.Equal("2018-11-04 01:00:00", startGap.Format(YYYY_MM_DD_HH_MM_SS))
+	assert.Equal("2018-11-03 23:59:59", endBeforeGap.Format(YYYY_MM_DD_HH_MM_SS))
+	assert.Equal(startGap, endBeforeGap.Add(time.Nanosecond))
+}
+
+func TestGetBeforeAndAfterTimeOfTimeIn_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+	input := time.Date(2021, 9, 11, 20, 0, 0, 0, time.UTC)
+
+	// WHEN
+	before, errBefore := GetBeforeTimeOfTimeIn(input, 12, true, AsiaHoChiMinh)
+	after, errAfter := GetAfterTimeOfTimeIn(input, 20, false, AsiaHoChiMinh)
+	_, errInvalid := GetAfterTimeOfTimeIn(input, 20, false, "Asia/Not_Exist")
+
+	// THEN
+	assert.Nil(errBefore)
+	assert.Nil(errAfter)
+	assert.NotNil(errInvalid)
+	assert.Equal(time.Date(2021, 8, 31, 0, 0, 0, 0, hcm), before)
+	assert.Equal(time.Date(2021, 10, 2, 23, 59, 59, 999999999, hcm), after)
+	assert.Equal(time.Date(2021, 9, 11, 23, 59, 59, 999999999, hcm), GetBeforeTimeOfTimeInLocation(input, 1, false, hcm))
+}
+
+func TestGetCurrentTimeIn_FakeClock_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	clock := NewFakeClock(time.Date(2021, 9, 11, 20, 0, 0, 0, time.UTC))
+
+	// WHEN
+	now := GetCurrentTimeInLocationWithClock(clock, MustLoadLocation(AsiaHoChiMinh))
+	nowReal, err := GetCurrentTimeIn(AsiaHoChiMinh)
+
+	// THEN
+	assert.Nil(err)
+	assert.Equal("2021-09-12 03:00:00", now.Format(YYYY_MM_DD_HH_MM_SS))
+	assert.Equal(AsiaHoChiMinh, nowReal.Location().String())
+}
+
+func BenchmarkLoadLocation(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = LoadLocation(AsiaHoChiMinh)
+	}
+}
+
+func BenchmarkStartOfDayIn(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	now := time.Now()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = StartOfDayIn(now, AsiaHoChiMinh)
+	}
+}
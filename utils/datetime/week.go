@@ -0,0 +1,60 @@
+package datetime
+
+import "time"
+
+// StartOfWeek returns the start time of week of t in the location of t.
+// The weekStart is the first day of week, usually time.Monday or time.Sunday.
+func StartOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	return startOfDate(t.Year(), t.Month(), t.Day()-daysSinceWeekStart(t, weekStart), t.Location())
+}
+
+// EndOfWeek returns the end time of week of t in the location of t.
+// The weekStart is the first day of week, usually time.Monday or time.Sunday.
+func EndOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	return startOfDate(t.Year(), t.Month(), t.Day()-daysSinceWeekStart(t, weekStart)+7, t.Location()).Add(-time.Nanosecond)
+}
+
+// ISOWeek returns the ISO 8601 week number of t. Value from 1 to 53.
+func ISOWeek(t time.Time) int {
+	_, week := t.ISOWeek()
+	return week
+}
+
+// ISOWeekYear returns the ISO 8601 week-year of t.
+// It may differ from the year of t in the first and the last days of year, e.g. 2021-01-01 belongs to the week-year 2020.
+func ISOWeekYear(t time.Time) int {
+	year, _ := t.ISOWeek()
+	return year
+}
+
+// StartOfISOWeek returns the start time of the ISO 8601 week of year in the location. The week starts on Monday.
+func StartOfISOWeek(year, week int, loc *time.Location) time.Time {
+	// January 4th is always in the first ISO week.
+	jan4 := time.Date(year, time.January, 4, 12, 0, 0, 0, loc)
+	firstMonday := StartOfWeek(jan4, time.Monday)
+
+	return startOfDate(firstMonday.Year(), firstMonday.Month(), firstMonday.Day()+(week-1)*7, loc)
+}
+
+// Quarter returns the quarter of year of t. Value from 1 to 4.
+func Quarter(t time.Time) int {
+	return (int(t.Month())-1)/3 + 1
+}
+
+// StartOfQuarter returns the start time of quarter of t in the location of t.
+func StartOfQuarter(t time.Time) time.Time {
+	return startOfDate(t.Year(), firstMonthOfQuarter(t), 1, t.Location())
+}
+
+// EndOfQuarter returns the end time of quarter of t in the location of t.
+func EndOfQuarter(t time.Time) time.Time {
+	return startOfDate(t.Year(), firstMonthOfQuarter(t)+3, 1, t.Location()).Add(-time.Nanosecond)
+}
+
+func daysSinceWeekStart(t time.Time, weekStart time.Weekday) int {
+	return (int(t.Weekday()) - int(weekStart) + 7) % 7
+}
+
+func firstMonthOfQuarter(t time.Time) time.Month {
+	return time.Month((Quarter(t)-1)*3 + 1)
+}
@@ -0,0 +1,149 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartAndEndOfWeek_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+	tables := []struct {
+		input         time.Time
+		weekStart     time.Weekday
+		expectedStart time.Time
+		expectedEnd   time.Time
+	}{
+		{
+			// Saturday
+			input:         time.Date(2021, 9, 11, 17, 55, 57, 0, hcm),
+			weekStart:     time.Monday,
+			expectedStart: time.Date(2021, 9, 6, 0, 0, 0, 0, hcm),
+			expectedEnd:   time.Date(2021, 9, 12, 23, 59, 59, 999999999, hcm),
+		},
+		{
+			input:         time.Date(2021, 9, 11, 17, 55, 57, 0, hcm),
+			weekStart:     time.Sunday,
+			expectedStart: time.Date(2021, 9, 5, 0, 0, 0, 0, hcm),
+			expectedEnd:   time.Date(2021, 9, 11, 23, 59, 59, 999999999, hcm),
+		},
+		{
+			// Sunday
+			input:         time.Date(2021, 9, 12, 0, 0, 0, 0, hcm),
+			weekStart:     time.Monday,
+			expectedStart: time.Date(2021, 9, 6, 0, 0, 0, 0, hcm),
+			expectedEnd:   time.Date(2021, 9, 12, 23, 59, 59, 999999999, hcm),
+		},
+		{
+			input:         time.Date(2021, 9, 12, 0, 0, 0, 0, hcm),
+			weekStart:     time.Sunday,
+			expectedStart: time.Date(2021, 9, 12, 0, 0, 0, 0, hcm),
+			expectedEnd:   time.Date(2021, 9, 18, 23, 59, 59, 999999999, hcm),
+		},
+		{
+			// Week across year
+			input:         time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC),
+			weekStart:     time.Monday,
+			expectedStart: time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2021, 1, 3, 23, 59, 59, 999999999, time.UTC),
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		start := StartOfWeek(table.input, table.weekStart)
+		end := EndOfWeek(table.input, table.weekStart)
+
+		// THEN
+		assert.Equal(table.expectedStart, start)
+		assert.Equal(table.expectedEnd, end)
+	}
+}
+
+func TestISOWeek_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		input            time.Time
+		expectedWeek     int
+		expectedWeekYear int
+	}{
+		{input: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), expectedWeek: 53, expectedWeekYear: 2020},
+		{input: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC), expectedWeek: 1, expectedWeekYear: 2021},
+		{input: time.Date(2021, 9, 11, 0, 0, 0, 0, time.UTC), expectedWeek: 36, expectedWeekYear: 2021},
+		{input: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), expectedWeek: 1, expectedWeekYear: 2025},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		week := ISOWeek(table.input)
+		weekYear := ISOWeekYear(table.input)
+
+		// THEN
+		assert.Equal(table.expectedWeek, week)
+		assert.Equal(table.expectedWeekYear, weekYear)
+		assert.Equal(StartOfWeek(table.input, time.Monday), StartOfISOWeek(weekYear, week, time.UTC))
+	}
+}
+
+func TestQuarter_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	hcm := MustLoadLocation(AsiaHoChiMinh)
+	tables := []struct {
+		input           time.Time
+		expectedQuarter int
+		expectedStart   time.Time
+		expectedEnd     time.Time
+	}{
+		{
+			input:           time.Date(2021, 1, 1, 0, 0, 0, 0, hcm),
+			expectedQuarter: 1,
+			expectedStart:   time.Date(2021, 1, 1, 0, 0, 0, 0, hcm),
+			expectedEnd:     time.Date(2021, 3, 31, 23, 59, 59, 999999999, hcm),
+		},
+		{
+			input:           time.Date(2021, 6, 30, 23, 59, 59, 0, hcm),
+			expectedQuarter: 2,
+			expectedStart:   time.Date(2021, 4, 1, 0, 0, 0, 0, hcm),
+			expectedEnd:     time.Date(2021, 6, 30, 23, 59, 59, 999999999, hcm),
+		},
+		{
+			input:           time.Date(2021, 9, 11, 17, 55, 57, 0, hcm),
+			expectedQuarter: 3,
+			expectedStart:   time.Date(2021, 7, 1, 0, 0, 0, 0, hcm),
+			expectedEnd:     time.Date(2021, 9, 30, 23, 59, 59, 999999999, hcm),
+		},
+		{
+			input:           time.Date(2021, 12, 31, 12, 0, 0, 0, hcm),
+			expectedQuarter: 4,
+			expectedStart:   time.Date(2021, 10, 1, 0, 0, 0, 0, hcm),
+			expectedEnd:     time.Date(2021, 12, 31, 23, 59, 59, 999999999, hcm),
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		quarter := Quarter(table.input)
+		start := StartOfQuarter(table.input)
+		end := EndOfQuarter(table.input)
+
+		// THEN
+		assert.Equal(table.expectedQuarter, quarter)
+		assert.Equal(table.expectedStart, start)
+		assert.Equal(table.expectedEnd, end)
+	}
+}
+
+func BenchmarkStartOfWeek(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	now := time.Now()
+
+	for i := 0; i < b.N; i++ {
+		StartOfWeek(now, time.Monday)
+	}
+}
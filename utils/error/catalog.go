@@ -0,0 +1,251 @@
+package ero
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/phamtai97/go-utils/internal/i18n"
+)
+
+const (
+	// LocaleEN is the English locale.
+	LocaleEN = i18n.EN
+	// LocaleVI is the Vietnamese locale.
+	LocaleVI = i18n.VI
+)
+
+// Args are the named arguments of message template. A placeholder {name} in template is replaced by Args["name"].
+type Args map[string]interface{}
+
+// CatalogEntry declares an error of catalog.
+//
+// Message is the default message template. Translations maps locale to message template, e.g.
+//
+// 	ero.CatalogEntry{
+// 		Code:    "ACCOUNT_NOT_FOUND",
+// 		Message: "Account {username} not found",
+// 		Translations: map[string]string{
+// 			ero.LocaleVI: "Không tìm thấy tài khoản {username}",
+// 		},
+// 	}
+type CatalogEntry struct {
+	Code         Code
+	Message      string
+	Translations map[string]string
+}
+
+// Catalog is the registry of errors that are declared once with a code, a default message template and translations.
+// It is safe for concurrent use.
+type Catalog struct {
+	mu            sync.RWMutex
+	defaultLocale string
+	entries       map[Code]CatalogEntry
+	sentinels     map[Code]*ErrorWrapper
+}
+
+var defaultCatalog = NewCatalog(LocaleEN)
+
+// NewCatalog returns the *Catalog with the default locale of the default message template.
+func NewCatalog(defaultLocale string) *Catalog {
+	return &Catalog{
+		defaultLocale: i18n.Normalize(defaultLocale),
+		entries:       make(map[Code]CatalogEntry),
+		sentinels:     make(map[Code]*ErrorWrapper),
+	}
+}
+
+// DefaultCatalog returns the global catalog that is used by Register, MustRegister and FromCatalog.
+func DefaultCatalog() *Catalog {
+	return defaultCatalog
+}
+
+// Register declares the entry into catalog and returns the sentinel error of entry.
+// Any error created from the entry is the sentinel error when checking with Is.
+func (c *Catalog) Register(entry CatalogEntry) (*ErrorWrapper, error) {
+	if len(entry.Code) == 0 {
+		return nil, New("Code of catalog entry must be not empty")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[entry.Code]; ok {
+		return nil, Newf("Code %s has been registered", entry.Code)
+	}
+
+	translations := make(map[string]string, len(entry.Translations))
+	for locale, template := range entry.Translations {
+		translations[i18n.Normalize(locale)] = template
+	}
+	entry.Translations = translations
+
+	sentinel := c.newError(entry, nil)
+	sentinel.sentinel = true
+
+	c.entries[entry.Code] = entry
+	c.sentinels[entry.Code] = sentinel
+	return sentinel, nil
+}
+
+// MustRegister is like Register but panics if the entry can not be registered.
+func (c *Catalog) MustRegister(entry CatalogEntry) *ErrorWrapper {
+	sentinel, err := c.Register(entry)
+	if err != nil {
+		panic(err)
+	}
+
+	return sentinel
+}
+
+// Sentinel returns the sentinel error of code.
+func (c *Catalog) Sentinel(code Code) (*ErrorWrapper, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	sentinel, ok := c.sentinels[code]
+	return sentinel, ok
+}
+
+// New returns the *ErrorWrapper of code with the arguments of message template.
+// The internal message contains the code, the default message and all arguments.
+// If the code has not been registered, it returns the error with CodeUnknown.
+func (c *Catalog) New(code Code, args Args) *ErrorWrapper {
+	c.mu.RLock()
+	entry, ok := c.entries[code]
+	c.mu.RUnlock()
+
+	if !ok {
+		return Newf("Code %s has not been registered in catalog", code)
+	}
+
+	return c.newError(entry, args)
+}
+
+// Message returns the message of code in the locale.
+// It falls back to the base language of locale, e.g. "vi" for "vi-VN", then the default message template.
+func (c *Catalog) Message(code Code, locale string, args Args) string {
+	c.mu.RLock()
+	entry, ok := c.entries[code]
+	c.mu.RUnlock()
+
+	if !ok {
+		return ""
+	}
+
+	return render(c.template(entry, locale), args)
+}
+
+func (c *Catalog) template(entry CatalogEntry, locale string) string {
+	locale = i18n.Normalize(locale)
+	if len(locale) == 0 || locale == c.defaultLocale {
+		return entry.Message
+	}
+
+	if template, ok := i18n.Lookup(entry.Translations, locale); ok {
+		return template
+	}
+
+	return entry.Message
+}
+
+func (c *Catalog) newError(entry CatalogEntry, args Args) *ErrorWrapper {
+	errWrapper := New(internalMessage(entry, args)).WithCode(entry.Code)
+	errWrapper.catalog = c
+	errWrapper.args = args
+
+	return errWrapper
+}
+
+// Register declares the entry into the default catalog and returns the sentinel error of entry.
+func Register(entry CatalogEntry) (*ErrorWrapper, error) {
+	return defaultCatalog.Register(entry)
+}
+
+// MustRegister declares the entry into the default catalog and returns the sentinel error of entry.
+// It panics if the entry can not be registered.
+func MustRegister(entry CatalogEntry) *ErrorWrapper {
+	return defaultCatalog.MustRegister(entry)
+}
+
+// FromCatalog returns the *ErrorWrapper of code in the default catalog with the arguments of message template.
+func FromCatalog(code Code, args Args) *ErrorWrapper {
+	return defaultCatalog.New(code, args)
+}
+
+// UserMessage returns the user-facing message of error in the locale.
+// It returns empty string if error has not been created from a Catalog.
+func (e *ErrorWrapper) UserMessage(locale string) string {
+	if e.catalog == nil {
+		return ""
+	}
+
+	return e.catalog.Message(e.code, locale, e.args)
+}
+
+// UserMessageOf returns the user-facing message of the first *ErrorWrapper in the error chain that has been created from a Catalog.
+func UserMessageOf(err error, locale string) string {
+	var errWrapper *ErrorWrapper
+	for errors.As(err, &errWrapper) {
+		if errWrapper.catalog != nil {
+			return errWrapper.UserMessage(locale)
+		}
+
+		err = errWrapper.err
+	}
+
+	return ""
+}
+
+func internalMessage(entry CatalogEntry, args Args) string {
+	message := fmt.Sprintf("%s: %s", entry.Code, render(entry.Message, args))
+	if len(args) == 0 {
+		return message
+	}
+
+	keys := make([]string, 0, len(args))
+	for key := range args {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", key, args[key]))
+	}
+
+	return message + " [" + strings.Join(pairs, " ") + "]"
+}
+
+func render(template string, args Args) string {
+	if len(args) == 0 || strings.IndexByte(template, '{') < 0 {
+		return template
+	}
+
+	var builder strings.Builder
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			break
+		}
+
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		builder.WriteString(template[:start])
+		if value, ok := args[template[start+1:end]]; ok {
+			fmt.Fprint(&builder, value)
+		} else {
+			builder.WriteString(template[start : end+1])
+		}
+		template = template[end+1:]
+	}
+	builder.WriteString(template)
+
+	return builder.String()
+}
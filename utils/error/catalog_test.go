@@ -0,0 +1,172 @@
+package ero
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCatalog() (*Catalog, *ErrorWrapper) {
+	catalog := NewCatalog(LocaleEN)
+	errAccountNotFound := catalog.MustRegister(CatalogEntry{
+		Code:    "ACCOUNT_NOT_FOUND",
+		Message: "Account {username} not found",
+		Translations: map[string]string{
+			LocaleVI: "Không tìm thấy tài khoản {username}",
+		},
+	})
+
+	return catalog, errAccountNotFound
+}
+
+func TestCatalogNew_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	catalog, _ := newTestCatalog()
+	tables := []struct {
+		locale          string
+		expectedMessage string
+	}{
+		{
+			locale:          "",
+			expectedMessage: "Account AJPham not found",
+		},
+		{
+			locale:          LocaleEN,
+			expectedMessage: "Account AJPham not found",
+		},
+		{
+			locale:          LocaleVI,
+			expectedMessage: "Không tìm thấy tài khoản AJPham",
+		},
+		{
+			locale:          "vi_VN",
+			expectedMessage: "Không tìm thấy tài khoản AJPham",
+		},
+		{
+			locale:          "fr",
+			expectedMessage: "Account AJPham not found",
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		err := catalog.New("ACCOUNT_NOT_FOUND", Args{"username": "AJPham", "table": "account"})
+
+		// THEN
+		assert.Equal(Code("ACCOUNT_NOT_FOUND"), err.Code())
+		assert.Equal("ACCOUNT_NOT_FOUND: Account AJPham not found [table=account username=AJPham]", err.Error())
+		assert.Equal(table.expectedMessage, err.UserMessage(table.locale))
+		assert.Equal(table.expectedMessage, UserMessageOf(err.AddContext("Component A called"), table.locale))
+	}
+}
+
+func TestCatalogNew_NotRegisteredCode_ReturnUnknownError(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	catalog, _ := newTestCatalog()
+
+	// WHEN
+	err := catalog.New("NOT_REGISTERED", nil)
+
+	// THEN
+	assert.Equal(CodeUnknown, err.Code())
+	assert.Equal("Code NOT_REGISTERED has not been registered in catalog", err.Error())
+	assert.Empty(err.UserMessage(LocaleEN))
+}
+
+func TestCatalogRegister_InvalidEntry_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	catalog, _ := newTestCatalog()
+
+	// WHEN
+	_, errDuplicate := catalog.Register(CatalogEntry{Code: "ACCOUNT_NOT_FOUND", Message: "Duplicate"})
+	_, errEmpty := catalog.Register(CatalogEntry{Message: "Empty code"})
+
+	// THEN
+	assert.Equal("Code ACCOUNT_NOT_FOUND has been registered", errDuplicate.Error())
+	assert.Equal("Code of catalog entry must be not empty", errEmpty.Error())
+	assert.Panics(func() {
+		catalog.MustRegister(CatalogEntry{Code: "ACCOUNT_NOT_FOUND"})
+	})
+}
+
+func TestIs_CatalogSentinel_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	catalog, errAccountNotFound := newTestCatalog()
+	errAccountLocked := catalog.MustRegister(CatalogEntry{Code: "ACCOUNT_LOCKED", Message: "Account locked"})
+	sentinel, ok := catalog.Sentinel("ACCOUNT_NOT_FOUND")
+
+	// WHEN
+	err := catalog.New("ACCOUNT_NOT_FOUND", Args{"username": "AJPham"}).AddStackTrace("Component A called")
+
+	// THEN
+	assert.True(ok)
+	assert.Equal(errAccountNotFound, sentinel)
+	assert.True(err.Is(errAccountNotFound))
+	assert.True(Wrap(err).Is(errAccountNotFound))
+	assert.False(err.Is(errAccountLocked))
+	assert.False(New("Account not found").WithCode("ACCOUNT_NOT_FOUND").Is(New("Account not found").WithCode("ACCOUNT_NOT_FOUND")))
+}
+
+var errTestInsufficientBalance = MustRegister(CatalogEntry{
+	Code:    "TEST_INSUFFICIENT_BALANCE",
+	Message: "Balance {balance} is not enough",
+	Translations: map[string]string{
+		LocaleVI: "Số dư {balance} không đủ",
+	},
+})
+
+func TestFromCatalog_DefaultCatalog_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	err := FromCatalog("TEST_INSUFFICIENT_BALANCE", Args{"balance": 1000})
+
+	// THEN
+	assert.True(err.Is(errTestInsufficientBalance))
+	assert.Equal("Số dư 1000 không đủ", err.UserMessage(LocaleVI))
+	assert.Equal("Balance 1000 is not enough", err.UserMessage(LocaleEN))
+}
+
+func TestWriteProblem_CatalogError_LocalizedDetail(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	catalog, _ := newTestCatalog()
+	mapper := NewStatusMapper("go-utils").Register("ACCOUNT_NOT_FOUND", Mapping{HTTPStatus: http.StatusNotFound, GRPCCode: GRPCNotFound})
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/accounts/AJPham", nil)
+	request.Header.Set("Accept-Language", "vi-VN,vi;q=0.9,en;q=0.8")
+	err := catalog.New("ACCOUNT_NOT_FOUND", Args{"username": "AJPham", "table": "account"})
+
+	// WHEN
+	errWrite := mapper.WriteProblem(recorder, request, err)
+	status := mapper.ToLocalizedGRPCStatus(err, LocaleEN)
+
+	// THEN
+	assert.Nil(errWrite)
+
+	var problem Problem
+	assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal("Không tìm thấy tài khoản AJPham", problem.Detail)
+	assert.NotContains(recorder.Body.String(), "table")
+	assert.Equal("Account AJPham not found", status.Message)
+}
+
+func BenchmarkFromCatalog(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	catalog, _ := newTestCatalog()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			catalog.New("ACCOUNT_NOT_FOUND", Args{"username": "AJPham"}).UserMessage(LocaleVI)
+		}
+	})
+}
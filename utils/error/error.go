@@ -1,10 +1,21 @@
 package ero
 
-import "github.com/pkg/errors"
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
 
 // ErrorWrapper wraps any error for ease of use.
 type ErrorWrapper struct {
-	err error
+	err       error
+	code      Code
+	metadata  map[string]string
+	retry     retryState
+	temporary bool
+	catalog   *Catalog
+	args      Args
+	sentinel  bool
 }
 
 // Wrap returns the *ErrorWrapper with exist error.
@@ -40,9 +51,7 @@ func (e *ErrorWrapper) Detail() error {
 
 // RootCause returns *ErrorWrapper that contains the root cause of error.
 func (e *ErrorWrapper) RootCause() *ErrorWrapper {
-	return &ErrorWrapper{
-		err: errors.Cause(e.err),
-	}
+	return e.derive(errors.Cause(e.err))
 }
 
 // RootCauseStr returns the root cause string.
@@ -52,33 +61,115 @@ func (e *ErrorWrapper) RootCauseStr() string {
 
 // AddStackTrace returns *ErrorWrapper containing error has been added stackstrace.
 func (e *ErrorWrapper) AddStackTrace(message string) *ErrorWrapper {
-	return &ErrorWrapper{
-		err: errors.Wrap(e.err, message),
-	}
+	return e.derive(errors.Wrap(e.err, message))
 }
 
 // AddStackTracef returns *ErrorWrapper containing error has been added stackstrace with format message.
 func (e *ErrorWrapper) AddStackTracef(format string, args ...interface{}) *ErrorWrapper {
-	return &ErrorWrapper{
-		err: errors.Wrapf(e.err, format, args...),
-	}
+	return e.derive(errors.Wrapf(e.err, format, args...))
 }
 
 // AddContext returns *ErrorWrapper containing error has been added context.
 func (e *ErrorWrapper) AddContext(message string) *ErrorWrapper {
-	return &ErrorWrapper{
-		err: errors.WithMessage(e.err, message),
-	}
+	return e.derive(errors.WithMessage(e.err, message))
 }
 
 // AddContextf returns *ErrorWrapper containing error has been added context with format message.
 func (e *ErrorWrapper) AddContextf(format string, args ...interface{}) *ErrorWrapper {
-	return &ErrorWrapper{
-		err: errors.WithMessagef(e.err, format, args...),
-	}
+	return e.derive(errors.WithMessagef(e.err, format, args...))
 }
 
 // Is checks current error is targer error.
+// If target is a sentinel that has been registered in a Catalog, errors with the same code are target error.
+//
+// Is does not walk through the other *ErrorWrapper in the error chain, so Wrap(err).Is(err) is false.
+// Use errors.Is to check the whole chain, e.g. errors.Is(Wrap(err), err) is true.
 func (e *ErrorWrapper) Is(target *ErrorWrapper) bool {
-	return errors.Is(e.err, target.err)
+	if isInWrapper(e.err, target.err) {
+		return true
+	}
+
+	return target.sentinel && CodeOf(e) == target.code
+}
+
+// Unwrap returns the error in the ErrorWrapper so that errors.Is and errors.As can walk through it.
+func (e *ErrorWrapper) Unwrap() error {
+	return e.err
+}
+
+// isInWrapper is like errors.Is but stops at *ErrorWrapper, which is the behavior of Is before ErrorWrapper has Unwrap.
+func isInWrapper(err, target error) bool {
+	if target == nil {
+		return err == target
+	}
+
+	comparable := reflect.TypeOf(target).Comparable()
+	for err != nil {
+		if comparable && err == target {
+			return true
+		}
+
+		if x, ok := err.(interface{ Is(error) bool }); ok && x.Is(target) {
+			return true
+		}
+
+		if _, ok := err.(*ErrorWrapper); ok {
+			return false
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return false
+}
+
+// WithCode returns *ErrorWrapper containing error has been assigned the code.
+func (e *ErrorWrapper) WithCode(code Code) *ErrorWrapper {
+	errWrapper := e.derive(e.err)
+	errWrapper.code = code
+	return errWrapper
+}
+
+// Code returns the code of error. It returns CodeUnknown if no code has been assigned.
+func (e *ErrorWrapper) Code() Code {
+	if len(e.code) == 0 {
+		return CodeUnknown
+	}
+
+	return e.code
+}
+
+// WithMetadata returns *ErrorWrapper containing error has been added the metadata key and value.
+func (e *ErrorWrapper) WithMetadata(key, value string) *ErrorWrapper {
+	errWrapper := e.derive(e.err)
+	errWrapper.metadata = make(map[string]string, len(e.metadata)+1)
+	for k, v := range e.metadata {
+		errWrapper.metadata[k] = v
+	}
+	errWrapper.metadata[key] = value
+
+	return errWrapper
+}
+
+// Metadata returns a copy of the metadata of error.
+func (e *ErrorWrapper) Metadata() map[string]string {
+	metadata := make(map[string]string, len(e.metadata))
+	for k, v := range e.metadata {
+		metadata[k] = v
+	}
+
+	return metadata
+}
+
+// derive returns *ErrorWrapper containing err and sharing code and metadata with current error.
+func (e *ErrorWrapper) derive(err error) *ErrorWrapper {
+	return &ErrorWrapper{
+		err:       err,
+		code:      e.code,
+		metadata:  e.metadata,
+		retry:     e.retry,
+		temporary: e.temporary,
+		catalog:   e.catalog,
+		args:      e.args,
+	}
 }
@@ -257,6 +257,26 @@ func TestIs_DifferentError_NotIs(t *testing.T) {
 	assert.False(isCheck)
 }
 
+func TestIs_WrapErrorWrapper_NotIs(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	err := New("Failed to open file")
+	errWrap := Wrap(err)
+
+	// WHEN
+	isCheck := errWrap.Is(err)
+	isCheckContext := errWrap.AddContext("Component A called").Is(err)
+	isCheckStd := errors.Is(errWrap, err)
+	isCheckStdContext := errors.Is(errWrap.AddContext("Component A called"), err)
+
+	// THEN
+	assert.False(isCheck)
+	assert.False(isCheckContext)
+	assert.True(isCheckStd)
+	assert.True(isCheckStdContext)
+	assert.True(errWrap.Is(Wrap(err)))
+}
+
 func BenchmarkCreateErrorWrapper(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
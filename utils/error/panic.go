@@ -0,0 +1,120 @@
+package ero
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+
+	"github.com/phamtai97/go-utils/utils/logger"
+	"go.uber.org/zap"
+)
+
+// PanicError is the error that has been recovered from a panic.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+// Error returns the error string.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// PanicHook is called with the error that has been recovered from a panic.
+type PanicHook func(err *ErrorWrapper, panicErr *PanicError)
+
+var panicHook atomic.Value
+
+func init() {
+	SetPanicHook(LogPanic)
+}
+
+// SetPanicHook sets the hook that is called every time a panic is recovered. A nil hook disables it.
+func SetPanicHook(hook PanicHook) {
+	if hook == nil {
+		hook = func(*ErrorWrapper, *PanicError) {}
+	}
+
+	panicHook.Store(hook)
+}
+
+// LogPanic is the default PanicHook, it logs the panic value and the stack through logger.Error.
+func LogPanic(err *ErrorWrapper, panicErr *PanicError) {
+	logger.Error("Recovered from panic",
+		zap.Error(err),
+		zap.String("panic", fmt.Sprintf("%v", panicErr.Value)),
+		zap.ByteString("stack", panicErr.Stack))
+}
+
+// Recover recovers from a panic and stores it as *ErrorWrapper with CodeInternal into err.
+// It must be called directly by defer. If err is nil, the panic is only passed to the hook.
+//
+// 	func doJob() (err error) {
+// 		defer ero.Recover(&err)
+// 		...
+// 	}
+func Recover(err *error) {
+	if r := recover(); r != nil {
+		errWrapper := newPanicError(r)
+		if err != nil {
+			*err = errWrapper
+		}
+	}
+}
+
+// Go runs fn in a new goroutine and returns the channel that receives the result of fn.
+// A panic in fn is converted into *ErrorWrapper.
+func Go(fn func() error) <-chan error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		var err error
+		defer func() {
+			errCh <- err
+			close(errCh)
+		}()
+		defer Recover(&err)
+
+		err = fn()
+	}()
+
+	return errCh
+}
+
+// SafeGo runs fn in a new goroutine. A panic in fn is recovered and passed to the hook instead of crashing the process.
+func SafeGo(fn func()) {
+	go func() {
+		defer Recover(nil)
+
+		fn()
+	}()
+}
+
+func newPanicError(value interface{}) *ErrorWrapper {
+	panicErr := &PanicError{
+		Value: value,
+		Stack: debug.Stack(),
+	}
+	errWrapper := Wrap(panicErr).WithCode(CodeInternal)
+
+	callPanicHook(errWrapper, panicErr)
+	return errWrapper
+}
+
+func callPanicHook(err *ErrorWrapper, panicErr *PanicError) {
+	// A panic in the hook such as logging without initializing logger must not crash the process.
+	defer func() {
+		_ = recover()
+	}()
+
+	panicHook.Load().(PanicHook)(err, panicErr)
+}
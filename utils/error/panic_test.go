@@ -0,0 +1,141 @@
+package ero
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func capturePanicHook() (*[]*PanicError, func()) {
+	var mu sync.Mutex
+	panicErrs := []*PanicError{}
+	SetPanicHook(func(err *ErrorWrapper, panicErr *PanicError) {
+		mu.Lock()
+		defer mu.Unlock()
+		panicErrs = append(panicErrs, panicErr)
+	})
+
+	return &panicErrs, func() {
+		SetPanicHook(LogPanic)
+	}
+}
+
+func doPanic(value interface{}) (err error) {
+	defer Recover(&err)
+
+	panic(value)
+}
+
+func TestRecover_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	panicErrs, restore := capturePanicHook()
+	defer restore()
+	tables := []struct {
+		value       interface{}
+		expectedErr string
+	}{
+		{
+			value:       "Failed to open file",
+			expectedErr: "panic: Failed to open file",
+		},
+		{
+			value:       123,
+			expectedErr: "panic: 123",
+		},
+		{
+			value:       io.EOF,
+			expectedErr: "panic: EOF",
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		err := doPanic(table.value)
+
+		// THEN
+		assert.NotNil(err)
+		assert.Equal(table.expectedErr, err.Error())
+		assert.Equal(CodeInternal, CodeOf(err))
+
+		var panicErr *PanicError
+		assert.True(errors.As(err, &panicErr))
+		assert.Equal(table.value, panicErr.Value)
+		assert.Contains(string(panicErr.Stack), "doPanic")
+	}
+
+	assert.Len(*panicErrs, 3)
+	assert.True(errors.Is(doPanic(io.EOF), io.EOF))
+}
+
+func TestRecover_NoPanic_NotChangeError(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	errOpenFile := New("Failed to open file")
+
+	// WHEN
+	err := func() (err error) {
+		defer Recover(&err)
+		return errOpenFile
+	}()
+
+	// THEN
+	assert.Equal(errOpenFile, err)
+}
+
+func TestGo_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	_, restore := capturePanicHook()
+	defer restore()
+	errOpenFile := New("Failed to open file")
+
+	// WHEN
+	errNil := <-Go(func() error { return nil })
+	errReturned := <-Go(func() error { return errOpenFile })
+	errPanic := <-Go(func() error { panic("Worker crashed") })
+
+	// THEN
+	assert.Nil(errNil)
+	assert.Equal(errOpenFile, errReturned)
+	assert.NotNil(errPanic)
+	assert.Equal("panic: Worker crashed", errPanic.Error())
+}
+
+func TestSafeGo_Panic_CallHook(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	done := make(chan *PanicError, 1)
+	SetPanicHook(func(err *ErrorWrapper, panicErr *PanicError) {
+		done <- panicErr
+	})
+	defer SetPanicHook(LogPanic)
+
+	// WHEN
+	SafeGo(func() {
+		panic("Worker crashed")
+	})
+
+	// THEN
+	panicErr := <-done
+	assert.Equal("Worker crashed", panicErr.Value)
+}
+
+func TestRecover_PanicHook_NotCrash(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	SetPanicHook(func(err *ErrorWrapper, panicErr *PanicError) {
+		panic("Hook crashed")
+	})
+	defer SetPanicHook(LogPanic)
+
+	// WHEN
+	err := doPanic("Worker crashed")
+
+	// THEN
+	assert.NotNil(err)
+	assert.Equal("panic: Worker crashed", err.Error())
+}
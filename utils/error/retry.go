@@ -0,0 +1,286 @@
+package ero
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"math/rand"
+	"syscall"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const (
+	// MySQLErrLockWaitTimeout is the MySQL error number of "Lock wait timeout exceeded".
+	MySQLErrLockWaitTimeout uint16 = 1205
+	// MySQLErrDeadlock is the MySQL error number of "Deadlock found when trying to get lock".
+	MySQLErrDeadlock uint16 = 1213
+)
+
+type retryState int8
+
+const (
+	retryUnset retryState = iota
+	retryRetryable
+	retryPermanent
+)
+
+// MarkRetryable returns *ErrorWrapper containing error has been marked as retryable.
+func (e *ErrorWrapper) MarkRetryable() *ErrorWrapper {
+	errWrapper := e.derive(e.err)
+	errWrapper.retry = retryRetryable
+	return errWrapper
+}
+
+// MarkPermanent returns *ErrorWrapper containing error has been marked as not retryable.
+func (e *ErrorWrapper) MarkPermanent() *ErrorWrapper {
+	errWrapper := e.derive(e.err)
+	errWrapper.retry = retryPermanent
+	return errWrapper
+}
+
+// MarkTemporary returns *ErrorWrapper containing error has been marked as temporary.
+// A temporary error is also retryable unless it has been marked as permanent.
+func (e *ErrorWrapper) MarkTemporary() *ErrorWrapper {
+	errWrapper := e.derive(e.err)
+	errWrapper.temporary = true
+	return errWrapper
+}
+
+// Temporary reports whether error has been marked as temporary or the error in the ErrorWrapper is temporary.
+func (e *ErrorWrapper) Temporary() bool {
+	if e.temporary {
+		return true
+	}
+
+	var temporary interface{ Temporary() bool }
+	return errors.As(e.err, &temporary) && temporary.Temporary()
+}
+
+// Classifier classifies error as retryable or not.
+// The ok result is false if the classifier can not make a decision for error.
+type Classifier func(err error) (retryable bool, ok bool)
+
+// DefaultClassifiers returns the built-in classifiers in the order they are applied.
+func DefaultClassifiers() []Classifier {
+	return []Classifier{
+		ClassifyMarked,
+		ClassifyContext,
+		ClassifyMySQL,
+		ClassifyConnection,
+		ClassifyTemporary,
+	}
+}
+
+// ClassifyMarked classifies error by the marks of the *ErrorWrapper in the error chain.
+func ClassifyMarked(err error) (bool, bool) {
+	var errWrapper *ErrorWrapper
+	for errors.As(err, &errWrapper) {
+		switch {
+		case errWrapper.retry == retryPermanent:
+			return false, true
+		case errWrapper.retry == retryRetryable, errWrapper.temporary:
+			return true, true
+		}
+
+		err = errWrapper.err
+	}
+
+	return false, false
+}
+
+// ClassifyContext classifies the canceled and deadline exceeded context errors as not retryable.
+func ClassifyContext(err error) (bool, bool) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false, true
+	}
+
+	return false, false
+}
+
+// ClassifyMySQL classifies the deadlock and lock wait timeout MySQL errors as retryable.
+// Other MySQL server errors such as duplicate entry are not retryable.
+func ClassifyMySQL(err error) (bool, bool) {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case MySQLErrDeadlock, MySQLErrLockWaitTimeout:
+			return true, true
+		default:
+			return false, true
+		}
+	}
+
+	if errors.Is(err, mysql.ErrInvalidConn) || errors.Is(err, driver.ErrBadConn) {
+		return true, true
+	}
+
+	return false, false
+}
+
+// ClassifyConnection classifies the connection refused and connection reset errors as retryable.
+func ClassifyConnection(err error) (bool, bool) {
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true, true
+	}
+
+	return false, false
+}
+
+// ClassifyTemporary classifies the errors that implement Temporary() bool such as net.Error.
+func ClassifyTemporary(err error) (bool, bool) {
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) {
+		return temporary.Temporary(), true
+	}
+
+	return false, false
+}
+
+// IsRetryable reports whether error is retryable by the default classifiers.
+func IsRetryable(err error) bool {
+	return classify(err, DefaultClassifiers())
+}
+
+// IsTemporary reports whether any error in the error chain is temporary.
+func IsTemporary(err error) bool {
+	retryable, ok := ClassifyTemporary(err)
+	return ok && retryable
+}
+
+// RetryPolicy contains config of retry with exponential backoff.
+//
+// The interval before the n-th retry is InitialInterval * Multiplier^(n-1), capped by MaxInterval.
+// It is randomized in [interval * (1 - RandomizationFactor), interval * (1 + RandomizationFactor)].
+//
+// MaxElapsedTime is the maximum total time of retry. If MaxElapsedTime <= 0, there is no limit.
+//
+// MaxAttempts is the maximum number of calls. If MaxAttempts <= 0, there is no limit.
+//
+// Classifiers are applied in order and the first decision wins. An error that no classifier
+// can make a decision for is not retried. If Classifiers is empty, DefaultClassifiers is used.
+type RetryPolicy struct {
+	InitialInterval     time.Duration
+	MaxInterval         time.Duration
+	Multiplier          float64
+	RandomizationFactor float64
+	MaxElapsedTime      time.Duration
+	MaxAttempts         int
+	Classifiers         []Classifier
+}
+
+// NewDefaultRetryPolicy returns the default retry policy.
+func NewDefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		InitialInterval:     100 * time.Millisecond,
+		MaxInterval:         10 * time.Second,
+		Multiplier:          2,
+		RandomizationFactor: 0.5,
+		MaxElapsedTime:      time.Minute,
+		MaxAttempts:         0,
+		Classifiers:         DefaultClassifiers(),
+	}
+}
+
+// Retry calls fn until it succeeds, returns an error that is not retryable, the policy is exhausted or ctx is done.
+// It returns nil if fn succeeds, the error of fn if it is not retryable, otherwise *ErrorWrapper containing
+// the last error of fn. If ctx is done, the error also matches the error of ctx by errors.Is and errors.As,
+// and it contains only the error of ctx if ctx is done before the first call.
+func Retry(ctx context.Context, policy RetryPolicy, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return Wrap(err).AddContext("Retry stopped before the first attempt")
+	}
+
+	classifiers := policy.Classifiers
+	if len(classifiers) == 0 {
+		classifiers = DefaultClassifiers()
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		if !classify(err, classifiers) {
+			return err
+		}
+
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return Wrap(err).AddContextf("Retry exhausted after %d attempts", attempt)
+		}
+
+		wait := policy.backoff(attempt)
+		if policy.MaxElapsedTime > 0 && time.Since(start)+wait > policy.MaxElapsedTime {
+			return Wrap(err).AddContextf("Retry exceeded max elapsed time %s after %d attempts", policy.MaxElapsedTime, attempt)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return Wrap(&stoppedError{err: err, ctxErr: ctx.Err()}).AddContextf("Retry stopped after %d attempts by %v", attempt, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// stoppedError is the last error of fn when ctx is done during Retry.
+// It unwraps to the last error and matches the error of ctx by Is and As,
+// since an error can unwrap to only one error before Go 1.20.
+type stoppedError struct {
+	err    error
+	ctxErr error
+}
+
+func (e *stoppedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *stoppedError) Unwrap() error {
+	return e.err
+}
+
+func (e *stoppedError) Is(target error) bool {
+	return errors.Is(e.ctxErr, target)
+}
+
+func (e *stoppedError) As(target interface{}) bool {
+	return errors.As(e.ctxErr, target)
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	interval := float64(p.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && interval > float64(p.MaxInterval) {
+		interval = float64(p.MaxInterval)
+	}
+
+	if interval > math.MaxInt64/2 {
+		interval = math.MaxInt64 / 2
+	}
+
+	if p.RandomizationFactor > 0 {
+		delta := p.RandomizationFactor * interval
+		interval = interval - delta + rand.Float64()*(2*delta)
+	}
+
+	return time.Duration(interval)
+}
+
+func classify(err error, classifiers []Classifier) bool {
+	for _, classifier := range classifiers {
+		if retryable, ok := classifier(err); ok {
+			return retryable
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,293 @@
+package ero
+
+import (
+	"context"
+	"database/sql/driver"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type temporaryError struct {
+	temporary bool
+}
+
+func (e temporaryError) Error() string {
+	return "temporary error"
+}
+
+func (e temporaryError) Temporary() bool {
+	return e.temporary
+}
+
+func newTestRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		InitialInterval:     time.Millisecond,
+		MaxInterval:         5 * time.Millisecond,
+		Multiplier:          2,
+		RandomizationFactor: 0.5,
+		MaxElapsedTime:      time.Second,
+		MaxAttempts:         5,
+	}
+}
+
+func TestIsRetryable_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	errConnRefused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	tables := []struct {
+		err               error
+		expectedRetryable bool
+	}{
+		{
+			err:               &mysql.MySQLError{Number: MySQLErrDeadlock, Message: "Deadlock found when trying to get lock"},
+			expectedRetryable: true,
+		},
+		{
+			err:               errors.Wrap(&mysql.MySQLError{Number: MySQLErrLockWaitTimeout, Message: "Lock wait timeout exceeded"}, "Failed to update account"),
+			expectedRetryable: true,
+		},
+		{
+			err:               Wrap(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}),
+			expectedRetryable: false,
+		},
+		{
+			err:               mysql.ErrInvalidConn,
+			expectedRetryable: true,
+		},
+		{
+			err:               driver.ErrBadConn,
+			expectedRetryable: true,
+		},
+		{
+			err:               errConnRefused,
+			expectedRetryable: true,
+		},
+		{
+			err:               Wrap(errConnRefused).AddContext("Failed to connect mysql"),
+			expectedRetryable: true,
+		},
+		{
+			err:               Wrap(errConnRefused).MarkPermanent(),
+			expectedRetryable: false,
+		},
+		{
+			err:               New("Failed to call service").MarkRetryable(),
+			expectedRetryable: true,
+		},
+		{
+			err:               New("Failed to call service").MarkTemporary().AddContext("Component A called"),
+			expectedRetryable: true,
+		},
+		{
+			err:               Wrap(temporaryError{temporary: true}),
+			expectedRetryable: true,
+		},
+		{
+			err:               temporaryError{temporary: false},
+			expectedRetryable: false,
+		},
+		{
+			err:               errors.Wrap(context.DeadlineExceeded, "Failed to query"),
+			expectedRetryable: false,
+		},
+		{
+			err:               New("Failed to open file"),
+			expectedRetryable: false,
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		retryable := IsRetryable(table.err)
+
+		// THEN
+		assert.Equal(table.expectedRetryable, retryable, table.err.Error())
+	}
+}
+
+func TestIsTemporary_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	// THEN
+	assert.True(IsTemporary(New("Failed to call service").MarkTemporary()))
+	assert.True(IsTemporary(errors.WithMessage(temporaryError{temporary: true}, "Component A called")))
+	assert.False(IsTemporary(New("Failed to call service").MarkRetryable()))
+	assert.False(IsTemporary(errors.New("Failed to open file")))
+}
+
+func TestRetry_SuccessAfterRetryableError_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	attempts := 0
+
+	// WHEN
+	err := Retry(context.Background(), newTestRetryPolicy(), func() error {
+		attempts++
+		if attempts < 3 {
+			return &mysql.MySQLError{Number: MySQLErrDeadlock, Message: "Deadlock found when trying to get lock"}
+		}
+
+		return nil
+	})
+
+	// THEN
+	assert.Nil(err)
+	assert.Equal(3, attempts)
+}
+
+func TestRetry_NotRetryableError_ReturnImmediately(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	attempts := 0
+	errDuplicate := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
+
+	// WHEN
+	err := Retry(context.Background(), newTestRetryPolicy(), func() error {
+		attempts++
+		return errDuplicate
+	})
+
+	// THEN
+	assert.Equal(errDuplicate, err)
+	assert.Equal(1, attempts)
+}
+
+func TestRetry_ExhaustedAttempts_ReturnLastError(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	attempts := 0
+	errUnavailable := New("Service unavailable").WithCode(CodeUnavailable).MarkRetryable()
+
+	// WHEN
+	err := Retry(context.Background(), newTestRetryPolicy(), func() error {
+		attempts++
+		return errUnavailable
+	})
+
+	// THEN
+	assert.NotNil(err)
+	assert.Equal(5, attempts)
+	assert.True(errors.Is(err, errUnavailable))
+	assert.Equal(CodeUnavailable, CodeOf(err))
+	assert.Equal("Retry exhausted after 5 attempts: Service unavailable", err.Error())
+}
+
+func TestRetry_ExceededMaxElapsedTime_ReturnLastError(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	policy := newTestRetryPolicy()
+	policy.MaxAttempts = 0
+	policy.InitialInterval = 20 * time.Millisecond
+	policy.MaxInterval = 20 * time.Millisecond
+	policy.RandomizationFactor = 0
+	policy.MaxElapsedTime = 50 * time.Millisecond
+	attempts := 0
+
+	// WHEN
+	err := Retry(context.Background(), policy, func() error {
+		attempts++
+		return New("Service unavailable").MarkRetryable()
+	})
+
+	// THEN
+	assert.NotNil(err)
+	assert.Equal(3, attempts)
+}
+
+func TestRetry_ContextCanceled_ReturnContextError(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := newTestRetryPolicy()
+	policy.InitialInterval = time.Hour
+	policy.MaxInterval = time.Hour
+	policy.MaxElapsedTime = 0
+
+	// WHEN
+	err := Retry(ctx, policy, func() error {
+		cancel()
+		return Wrap(&mysql.MySQLError{Number: MySQLErrDeadlock, Message: "Deadlock found when trying to get lock"}).AddContext("Failed to update account")
+	})
+	errCanceled := Retry(ctx, policy, func() error {
+		return nil
+	})
+
+	// THEN
+	var mysqlErr *mysql.MySQLError
+	assert.True(errors.Is(err, context.Canceled))
+	assert.True(errors.As(err, &mysqlErr))
+	assert.Equal(MySQLErrDeadlock, mysqlErr.Number)
+	assert.False(IsRetryable(err))
+	assert.Equal("Retry stopped after 1 attempts by context canceled: Failed to update account: Error 1213: Deadlock found when trying to get lock", err.Error())
+	assert.True(errors.Is(errCanceled, context.Canceled))
+	assert.Equal("Retry stopped before the first attempt: context canceled", errCanceled.Error())
+}
+
+func TestRetry_ContextDeadlineExceeded_KeepLastError(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	policy := newTestRetryPolicy()
+	policy.InitialInterval = time.Hour
+	policy.MaxInterval = time.Hour
+	policy.MaxElapsedTime = 0
+	errUnavailable := New("Service unavailable").MarkRetryable()
+
+	// WHEN
+	err := Retry(ctx, policy, func() error {
+		return errUnavailable
+	})
+
+	// THEN
+	var errWrapper *ErrorWrapper
+	assert.True(errors.Is(err, context.DeadlineExceeded))
+	assert.True(errors.Is(err, errUnavailable))
+	assert.True(errors.As(err, &errWrapper))
+	assert.True(IsRetryable(err))
+}
+
+func TestRetryPolicyBackoff_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	policy := RetryPolicy{
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     time.Second,
+		Multiplier:      2,
+	}
+
+	// WHEN
+	// THEN
+	assert.Equal(100*time.Millisecond, policy.backoff(1))
+	assert.Equal(200*time.Millisecond, policy.backoff(2))
+	assert.Equal(800*time.Millisecond, policy.backoff(4))
+	assert.Equal(time.Second, policy.backoff(5))
+	assert.Equal(time.Second, policy.backoff(1000))
+
+	policy.RandomizationFactor = 0.5
+	for i := 0; i < 100; i++ {
+		wait := policy.backoff(2)
+		assert.True(wait >= 100*time.Millisecond && wait <= 300*time.Millisecond)
+	}
+}
+
+func BenchmarkIsRetryable(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	err := Wrap(&mysql.MySQLError{Number: MySQLErrDeadlock, Message: "Deadlock found when trying to get lock"}).AddContext("Component A called")
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			IsRetryable(err)
+		}
+	})
+}
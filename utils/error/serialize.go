@@ -0,0 +1,396 @@
+package ero
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const binaryVersion byte = 1
+
+// EncodeOptions allows users to configure what is serialized with error.
+//
+// IncludeStack serializes the stack trace of error. It is false by default
+// because the stack trace is large and contains internal information.
+type EncodeOptions struct {
+	IncludeStack bool
+}
+
+// RemoteError is the root cause of error that has been reconstructed from serialized data.
+// It keeps the message and the stack trace of the original error.
+type RemoteError struct {
+	Message string
+	Stack   string
+}
+
+// Error returns the error string.
+func (e *RemoteError) Error() string {
+	return e.Message
+}
+
+// Format prints the stack trace of the original error with %+v.
+func (e *RemoteError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') && len(e.Stack) > 0 {
+			_, _ = io.WriteString(s, e.Message+"\n"+e.Stack)
+			return
+		}
+		fallthrough
+	case 's':
+		_, _ = io.WriteString(s, e.Message)
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Message)
+	}
+}
+
+type errorPayload struct {
+	Messages  []string          `json:"messages"`
+	Code      Code              `json:"code,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Args      Args              `json:"args,omitempty"`
+	Retry     retryState        `json:"retry,omitempty"`
+	Temporary bool              `json:"temporary,omitempty"`
+	Stack     string            `json:"stack,omitempty"`
+}
+
+// MarshalJSON encodes error to JSON without the stack trace.
+// The message chain, code, metadata, arguments of catalog and retry marks are preserved.
+func (e *ErrorWrapper) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newErrorPayload(e, EncodeOptions{}))
+}
+
+// UnmarshalJSON decodes error from JSON that has been encoded by MarshalJSON or EncodeJSON.
+func (e *ErrorWrapper) UnmarshalJSON(data []byte) error {
+	var payload errorPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*e = *payload.toErrorWrapper()
+	return nil
+}
+
+// MarshalBinary encodes error to the compact binary form without the stack trace.
+func (e *ErrorWrapper) MarshalBinary() ([]byte, error) {
+	return newErrorPayload(e, EncodeOptions{}).marshalBinary()
+}
+
+// UnmarshalBinary decodes error from the compact binary form that has been encoded by MarshalBinary or EncodeBinary.
+func (e *ErrorWrapper) UnmarshalBinary(data []byte) error {
+	var payload errorPayload
+	if err := payload.unmarshalBinary(data); err != nil {
+		return err
+	}
+
+	*e = *payload.toErrorWrapper()
+	return nil
+}
+
+// EncodeJSON encodes any error to JSON with options.
+func EncodeJSON(err error, opts EncodeOptions) ([]byte, error) {
+	return json.Marshal(newErrorPayload(err, opts))
+}
+
+// DecodeJSON decodes error from JSON.
+func DecodeJSON(data []byte) (*ErrorWrapper, error) {
+	errWrapper := &ErrorWrapper{}
+	if err := errWrapper.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+
+	return errWrapper, nil
+}
+
+// EncodeBinary encodes any error to the compact binary form with options.
+func EncodeBinary(err error, opts EncodeOptions) ([]byte, error) {
+	return newErrorPayload(err, opts).marshalBinary()
+}
+
+// DecodeBinary decodes error from the compact binary form.
+func DecodeBinary(data []byte) (*ErrorWrapper, error) {
+	errWrapper := &ErrorWrapper{}
+	if err := errWrapper.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+
+	return errWrapper, nil
+}
+
+func newErrorPayload(err error, opts EncodeOptions) errorPayload {
+	payload := errorPayload{
+		Messages: messageChain(err),
+	}
+
+	if code := CodeOf(err); code != CodeUnknown {
+		payload.Code = code
+	}
+
+	var errWrapper *ErrorWrapper
+	if errors.As(err, &errWrapper) {
+		payload.Metadata = errWrapper.metadata
+		payload.Args = errWrapper.args
+	}
+
+	if retryable, ok := ClassifyMarked(err); ok {
+		payload.Retry = retryPermanent
+		if retryable {
+			payload.Retry = retryRetryable
+		}
+	}
+	payload.Temporary = IsTemporary(err)
+
+	if opts.IncludeStack {
+		payload.Stack = stackOf(err)
+	}
+
+	return payload
+}
+
+func (p errorPayload) toErrorWrapper() *ErrorWrapper {
+	root := &RemoteError{Stack: p.Stack}
+	if len(p.Messages) > 0 {
+		root.Message = p.Messages[len(p.Messages)-1]
+	}
+
+	var err error = root
+	for i := len(p.Messages) - 2; i >= 0; i-- {
+		err = pkgerrors.WithMessage(err, p.Messages[i])
+	}
+
+	errWrapper := &ErrorWrapper{
+		err:       err,
+		code:      p.Code,
+		metadata:  p.Metadata,
+		args:      p.Args,
+		retry:     p.Retry,
+		temporary: p.Temporary,
+	}
+
+	if len(p.Code) > 0 {
+		if _, ok := defaultCatalog.Sentinel(p.Code); ok {
+			errWrapper.catalog = defaultCatalog
+		}
+	}
+
+	return errWrapper
+}
+
+func (p errorPayload) marshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(binaryVersion)
+	buf.WriteByte(byte(p.Retry))
+	writeBool(&buf, p.Temporary)
+	writeString(&buf, string(p.Code))
+
+	writeUvarint(&buf, uint64(len(p.Messages)))
+	for _, message := range p.Messages {
+		writeString(&buf, message)
+	}
+
+	writeUvarint(&buf, uint64(len(p.Metadata)))
+	for key, value := range p.Metadata {
+		writeString(&buf, key)
+		writeString(&buf, value)
+	}
+
+	args := []byte{}
+	if len(p.Args) > 0 {
+		var err error
+		if args, err = json.Marshal(p.Args); err != nil {
+			return nil, err
+		}
+	}
+	writeString(&buf, string(args))
+	writeString(&buf, p.Stack)
+
+	return buf.Bytes(), nil
+}
+
+func (p *errorPayload) unmarshalBinary(data []byte) error {
+	reader := bytes.NewReader(data)
+
+	version, err := reader.ReadByte()
+	if err != nil {
+		return invalidBinaryError(err)
+	}
+
+	if version != binaryVersion {
+		return Newf("Can not support binary error version %d", version)
+	}
+
+	retry, err := reader.ReadByte()
+	if err != nil {
+		return invalidBinaryError(err)
+	}
+	p.Retry = retryState(retry)
+
+	temporary, err := reader.ReadByte()
+	if err != nil {
+		return invalidBinaryError(err)
+	}
+	p.Temporary = temporary == 1
+
+	code, err := readString(reader)
+	if err != nil {
+		return invalidBinaryError(err)
+	}
+	p.Code = Code(code)
+
+	numMessages, err := readLength(reader)
+	if err != nil {
+		return invalidBinaryError(err)
+	}
+
+	p.Messages = make([]string, 0, numMessages)
+	for i := 0; i < numMessages; i++ {
+		message, err := readString(reader)
+		if err != nil {
+			return invalidBinaryError(err)
+		}
+		p.Messages = append(p.Messages, message)
+	}
+
+	numMetadata, err := readLength(reader)
+	if err != nil {
+		return invalidBinaryError(err)
+	}
+
+	if numMetadata > 0 {
+		p.Metadata = make(map[string]string, numMetadata)
+	}
+
+	for i := 0; i < numMetadata; i++ {
+		key, err := readString(reader)
+		if err != nil {
+			return invalidBinaryError(err)
+		}
+
+		value, err := readString(reader)
+		if err != nil {
+			return invalidBinaryError(err)
+		}
+		p.Metadata[key] = value
+	}
+
+	args, err := readString(reader)
+	if err != nil {
+		return invalidBinaryError(err)
+	}
+
+	if len(args) > 0 {
+		if err := json.Unmarshal([]byte(args), &p.Args); err != nil {
+			return invalidBinaryError(err)
+		}
+	}
+
+	if p.Stack, err = readString(reader); err != nil {
+		return invalidBinaryError(err)
+	}
+
+	return nil
+}
+
+// messageChain returns the messages of error from the outermost context to the root cause.
+func messageChain(err error) []string {
+	messages := []string{}
+	for err != nil {
+		message := err.Error()
+		next := errors.Unwrap(err)
+		if next == nil {
+			return append(messages, message)
+		}
+
+		nextMessage := next.Error()
+		switch {
+		case message == nextMessage:
+		case strings.HasSuffix(message, ": "+nextMessage):
+			messages = append(messages, strings.TrimSuffix(message, ": "+nextMessage))
+		default:
+			return append(messages, message)
+		}
+
+		err = next
+	}
+
+	return messages
+}
+
+// stackOf returns the stack trace of the deepest error in the error chain that has stack trace.
+func stackOf(err error) string {
+	var remoteErr *RemoteError
+	if errors.As(err, &remoteErr) {
+		return remoteErr.Stack
+	}
+
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		return string(panicErr.Stack)
+	}
+
+	stack := ""
+	for ; err != nil; err = errors.Unwrap(err) {
+		if tracer, ok := err.(interface{ StackTrace() pkgerrors.StackTrace }); ok {
+			stack = strings.TrimPrefix(fmt.Sprintf("%+v", tracer.StackTrace()), "\n")
+		}
+	}
+
+	return stack
+}
+
+func invalidBinaryError(err error) error {
+	return Wrap(err).AddContext("Invalid binary error data")
+}
+
+func writeBool(buf *bytes.Buffer, b bool) {
+	if b {
+		buf.WriteByte(1)
+		return
+	}
+
+	buf.WriteByte(0)
+}
+
+func writeUvarint(buf *bytes.Buffer, v uint64) {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	buf.Write(tmp[:n])
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	writeUvarint(buf, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+func readLength(reader *bytes.Reader) (int, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return 0, err
+	}
+
+	if length > uint64(reader.Len()) {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return int(length), nil
+}
+
+func readString(reader *bytes.Reader) (string, error) {
+	length, err := readLength(reader)
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
@@ -0,0 +1,200 @@
+package ero
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTestQueueFull = MustRegister(CatalogEntry{
+	Code:         "TEST_QUEUE_FULL",
+	Message:      "Queue {queue} is full",
+	Translations: map[string]string{LocaleVI: "Hàng đợi {queue} đã đầy"},
+})
+
+func TestMarshalJSON_RoundTrip_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	tables := []struct {
+		err error
+	}{
+		{
+			err: New("Failed to open file"),
+		},
+		{
+			err: New("Failed to open file").AddContext("Component A called").AddStackTrace("Component B called"),
+		},
+		{
+			err: New("Account not found").WithCode(CodeNotFound).WithMetadata("username", "AJPham").AddContextf("Component=%s called", "A"),
+		},
+		{
+			err: Wrap(errors.New("Lock wait timeout exceeded")).MarkRetryable().MarkTemporary(),
+		},
+		{
+			err: FromCatalog("TEST_QUEUE_FULL", Args{"queue": "settlement"}).AddContext("Worker called"),
+		},
+	}
+
+	for _, table := range tables {
+		original := table.err.(*ErrorWrapper)
+
+		// WHEN
+		data, errMarshal := json.Marshal(original)
+		decoded := &ErrorWrapper{}
+		errUnmarshal := json.Unmarshal(data, decoded)
+
+		// THEN
+		assert.Nil(errMarshal)
+		assert.Nil(errUnmarshal)
+		assert.Equal(original.Error(), decoded.Error())
+		assert.Equal(CodeOf(original), CodeOf(decoded))
+		assert.Equal(original.Metadata(), decoded.Metadata())
+		assert.Equal(IsRetryable(original), IsRetryable(decoded))
+		assert.Equal(IsTemporary(original), IsTemporary(decoded))
+		assert.Equal(original.RootCauseStr(), decoded.RootCauseStr())
+		assert.NotContains(string(data), "stack")
+	}
+}
+
+func TestMarshalJSON_SimpleInput_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	err := New("Account not found").WithCode(CodeNotFound).WithMetadata("username", "AJPham").AddContext("Component A called")
+
+	// WHEN
+	data, errMarshal := json.Marshal(err)
+
+	// THEN
+	assert.Nil(errMarshal)
+	assert.JSONEq(`{"messages":["Component A called","Account not found"],"code":"NOT_FOUND","metadata":{"username":"AJPham"}}`, string(data))
+}
+
+func TestMarshalBinary_RoundTrip_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	original := FromCatalog("TEST_QUEUE_FULL", Args{"queue": "settlement"}).
+		WithMetadata("worker", "1").
+		MarkRetryable().
+		AddStackTrace("Component A called")
+
+	// WHEN
+	data, errMarshal := original.MarshalBinary()
+	decoded, errDecode := DecodeBinary(data)
+
+	// THEN
+	assert.Nil(errMarshal)
+	assert.Nil(errDecode)
+	assert.Equal(original.Error(), decoded.Error())
+	assert.Equal(Code("TEST_QUEUE_FULL"), decoded.Code())
+	assert.Equal(map[string]string{"worker": "1"}, decoded.Metadata())
+	assert.True(IsRetryable(decoded))
+	assert.True(decoded.Is(errTestQueueFull))
+	assert.Equal("Hàng đợi settlement đã đầy", decoded.UserMessage(LocaleVI))
+}
+
+func TestDecodeJSON_RegisteredSentinel_IsSentinel(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	data, _ := json.Marshal(FromCatalog("TEST_QUEUE_FULL", Args{"queue": "settlement"}))
+
+	// WHEN
+	decoded, err := DecodeJSON(data)
+
+	// THEN
+	assert.Nil(err)
+	assert.True(decoded.Is(errTestQueueFull))
+	assert.True(decoded.AddContext("Consumer called").Is(errTestQueueFull))
+	assert.Equal("Queue settlement is full", decoded.UserMessage(LocaleEN))
+}
+
+func TestEncodeJSON_IncludeStack_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	err := New("Failed to open file").AddContext("Component A called")
+
+	// WHEN
+	data, errEncode := EncodeJSON(err, EncodeOptions{IncludeStack: true})
+	decoded, errDecode := DecodeJSON(data)
+
+	// THEN
+	assert.Nil(errEncode)
+	assert.Nil(errDecode)
+
+	var remoteErr *RemoteError
+	assert.True(errors.As(decoded, &remoteErr))
+	assert.Contains(remoteErr.Stack, "TestEncodeJSON_IncludeStack_Success")
+	assert.Contains(fmt.Sprintf("%+v", decoded.RootCause().Detail()), "TestEncodeJSON_IncludeStack_Success")
+	assert.Equal("Failed to open file", fmt.Sprintf("%v", remoteErr))
+
+	// Re-encode keeps the original stack
+	dataAgain, _ := EncodeBinary(decoded, EncodeOptions{IncludeStack: true})
+	decodedAgain, _ := DecodeBinary(dataAgain)
+	assert.True(errors.As(decodedAgain, &remoteErr))
+	assert.Contains(remoteErr.Stack, "TestEncodeJSON_IncludeStack_Success")
+}
+
+func TestEncodeJSON_StandardError_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	err := fmt.Errorf("Component A called: %w", errors.New("Failed to open file"))
+
+	// WHEN
+	data, errEncode := EncodeJSON(err, EncodeOptions{})
+	decoded, errDecode := DecodeJSON(data)
+
+	// THEN
+	assert.Nil(errEncode)
+	assert.Nil(errDecode)
+	assert.Equal(err.Error(), decoded.Error())
+	assert.Equal(CodeUnknown, decoded.Code())
+}
+
+func TestDecodeBinary_InvalidData_Failed(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	data, _ := New("Failed to open file").MarshalBinary()
+	tables := []struct {
+		data []byte
+	}{
+		{data: []byte{}},
+		{data: []byte{2}},
+		{data: data[:len(data)-3]},
+		{data: []byte{binaryVersion, 0, 0, 100}},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		decoded, err := DecodeBinary(table.data)
+
+		// THEN
+		assert.NotNil(err)
+		assert.Nil(decoded)
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	err := New("Benchmark create error").WithCode(CodeNotFound).AddContext("Component A called")
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = err.MarshalJSON()
+		}
+	})
+}
+
+func BenchmarkMarshalBinary(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	err := New("Benchmark create error").WithCode(CodeNotFound).AddContext("Component A called")
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = err.MarshalBinary()
+		}
+	})
+}
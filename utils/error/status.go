@@ -0,0 +1,381 @@
+package ero
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Code is the code of error that is used to map error into the HTTP status and the gRPC status.
+type Code string
+
+const (
+	// CodeUnknown is the code of error that has not been assigned any code.
+	CodeUnknown Code = "UNKNOWN"
+	// CodeCanceled indicates the operation was canceled.
+	CodeCanceled Code = "CANCELED"
+	// CodeInvalidArgument indicates client specified an invalid argument.
+	CodeInvalidArgument Code = "INVALID_ARGUMENT"
+	// CodeDeadlineExceeded means operation expired before completion.
+	CodeDeadlineExceeded Code = "DEADLINE_EXCEEDED"
+	// CodeNotFound means some requested entity was not found.
+	CodeNotFound Code = "NOT_FOUND"
+	// CodeAlreadyExists means an attempt to create an entity failed because one already exists.
+	CodeAlreadyExists Code = "ALREADY_EXISTS"
+	// CodePermissionDenied indicates the caller does not have permission to execute the operation.
+	CodePermissionDenied Code = "PERMISSION_DENIED"
+	// CodeResourceExhausted indicates some resource has been exhausted.
+	CodeResourceExhausted Code = "RESOURCE_EXHAUSTED"
+	// CodeFailedPrecondition indicates operation was rejected because the system is not in a required state.
+	CodeFailedPrecondition Code = "FAILED_PRECONDITION"
+	// CodeAborted indicates the operation was aborted, typically due to a concurrency issue.
+	CodeAborted Code = "ABORTED"
+	// CodeUnimplemented indicates operation is not implemented or not supported.
+	CodeUnimplemented Code = "UNIMPLEMENTED"
+	// CodeInternal means some invariants expected by underlying system has been broken.
+	CodeInternal Code = "INTERNAL"
+	// CodeUnavailable indicates the service is currently unavailable.
+	CodeUnavailable Code = "UNAVAILABLE"
+	// CodeUnauthenticated indicates the request does not have valid authentication credentials.
+	CodeUnauthenticated Code = "UNAUTHENTICATED"
+)
+
+// GRPCCode is the gRPC status code. The values are the same as google.golang.org/grpc/codes.
+type GRPCCode uint32
+
+const (
+	// GRPCOK is returned on success.
+	GRPCOK GRPCCode = 0
+	// GRPCCanceled indicates the operation was canceled.
+	GRPCCanceled GRPCCode = 1
+	// GRPCUnknown error.
+	GRPCUnknown GRPCCode = 2
+	// GRPCInvalidArgument indicates client specified an invalid argument.
+	GRPCInvalidArgument GRPCCode = 3
+	// GRPCDeadlineExceeded means operation expired before completion.
+	GRPCDeadlineExceeded GRPCCode = 4
+	// GRPCNotFound means some requested entity was not found.
+	GRPCNotFound GRPCCode = 5
+	// GRPCAlreadyExists means an attempt to create an entity failed because one already exists.
+	GRPCAlreadyExists GRPCCode = 6
+	// GRPCPermissionDenied indicates the caller does not have permission to execute the operation.
+	GRPCPermissionDenied GRPCCode = 7
+	// GRPCResourceExhausted indicates some resource has been exhausted.
+	GRPCResourceExhausted GRPCCode = 8
+	// GRPCFailedPrecondition indicates operation was rejected because the system is not in a required state.
+	GRPCFailedPrecondition GRPCCode = 9
+	// GRPCAborted indicates the operation was aborted.
+	GRPCAborted GRPCCode = 10
+	// GRPCOutOfRange means operation was attempted past the valid range.
+	GRPCOutOfRange GRPCCode = 11
+	// GRPCUnimplemented indicates operation is not implemented or not supported.
+	GRPCUnimplemented GRPCCode = 12
+	// GRPCInternal errors.
+	GRPCInternal GRPCCode = 13
+	// GRPCUnavailable indicates the service is currently unavailable.
+	GRPCUnavailable GRPCCode = 14
+	// GRPCDataLoss indicates unrecoverable data loss or corruption.
+	GRPCDataLoss GRPCCode = 15
+	// GRPCUnauthenticated indicates the request does not have valid authentication credentials.
+	GRPCUnauthenticated GRPCCode = 16
+)
+
+var grpcCodeNames = map[GRPCCode]string{
+	GRPCOK:                 "OK",
+	GRPCCanceled:           "Canceled",
+	GRPCUnknown:            "Unknown",
+	GRPCInvalidArgument:    "InvalidArgument",
+	GRPCDeadlineExceeded:   "DeadlineExceeded",
+	GRPCNotFound:           "NotFound",
+	GRPCAlreadyExists:      "AlreadyExists",
+	GRPCPermissionDenied:   "PermissionDenied",
+	GRPCResourceExhausted:  "ResourceExhausted",
+	GRPCFailedPrecondition: "FailedPrecondition",
+	GRPCAborted:            "Aborted",
+	GRPCOutOfRange:         "OutOfRange",
+	GRPCUnimplemented:      "Unimplemented",
+	GRPCInternal:           "Internal",
+	GRPCUnavailable:        "Unavailable",
+	GRPCDataLoss:           "DataLoss",
+	GRPCUnauthenticated:    "Unauthenticated",
+}
+
+// String returns the name of gRPC code.
+func (c GRPCCode) String() string {
+	if name, ok := grpcCodeNames[c]; ok {
+		return name
+	}
+
+	return "Code(" + strconv.FormatUint(uint64(c), 10) + ")"
+}
+
+// ProblemContentType is the content type of the RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+// Mapping describes how an error code is exposed to clients.
+//
+// Message is the public message returned to clients instead of the internal error message.
+// If Message is empty, the HTTP status text is used.
+//
+// ExposeMetadata allows the metadata of error to be returned to clients. It is false by default
+// because metadata may contain internal information.
+//
+// If HTTPStatus is not set, http.StatusInternalServerError is used. If GRPCCode is GRPCOK, GRPCUnknown is used
+// because an error must not be returned as success.
+type Mapping struct {
+	HTTPStatus     int
+	GRPCCode       GRPCCode
+	Type           string
+	Title          string
+	Message        string
+	ExposeMetadata bool
+}
+
+// Problem is the RFC 7807 problem details of error.
+type Problem struct {
+	Type     string            `json:"type"`
+	Title    string            `json:"title"`
+	Status   int               `json:"status"`
+	Detail   string            `json:"detail,omitempty"`
+	Instance string            `json:"instance,omitempty"`
+	Code     Code              `json:"code"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ErrorInfo is the detail of gRPC status, it is the same as google.rpc.ErrorInfo.
+type ErrorInfo struct {
+	Reason   string
+	Domain   string
+	Metadata map[string]string
+}
+
+// GRPCStatus is the gRPC status of error.
+type GRPCStatus struct {
+	Code    GRPCCode
+	Message string
+	Details []ErrorInfo
+}
+
+// StatusMapper maps errors into the HTTP status and the gRPC status.
+// It is safe for concurrent use.
+type StatusMapper struct {
+	mu       sync.RWMutex
+	domain   string
+	mappings map[Code]Mapping
+	fallback Mapping
+}
+
+// NewStatusMapper returns the *StatusMapper with the default mappings of built-in codes.
+// Domain is used as the domain of ErrorInfo in gRPC status.
+func NewStatusMapper(domain string) *StatusMapper {
+	mapper := &StatusMapper{
+		domain:   domain,
+		mappings: make(map[Code]Mapping, len(defaultMappings)),
+		fallback: defaultMappings[CodeUnknown],
+	}
+
+	for code, mapping := range defaultMappings {
+		mapper.mappings[code] = mapping
+	}
+
+	return mapper
+}
+
+// Register registers or overrides the mapping of code.
+func (m *StatusMapper) Register(code Code, mapping Mapping) *StatusMapper {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mappings[code] = mapping.withDefaults()
+	return m
+}
+
+// SetFallback sets the mapping that is used when the code of error has not been registered.
+func (m *StatusMapper) SetFallback(mapping Mapping) *StatusMapper {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.fallback = mapping.withDefaults()
+	return m
+}
+
+// Mapping returns the mapping of code. It returns the fallback mapping if the code has not been registered.
+func (m *StatusMapper) Mapping(code Code) Mapping {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if mapping, ok := m.mappings[code]; ok {
+		return mapping
+	}
+
+	return m.fallback
+}
+
+// ToProblem converts error into the HTTP status and the RFC 7807 problem details.
+// The internal message of error is never returned.
+func (m *StatusMapper) ToProblem(err error) Problem {
+	return m.ToLocalizedProblem(err, "")
+}
+
+// ToLocalizedProblem is like ToProblem but the detail is the user-facing message of error in the locale
+// if error has been created from a Catalog.
+func (m *StatusMapper) ToLocalizedProblem(err error, locale string) Problem {
+	code := CodeOf(err)
+	mapping := m.Mapping(code)
+
+	problem := Problem{
+		Type:   mapping.Type,
+		Title:  mapping.Title,
+		Status: mapping.HTTPStatus,
+		Detail: publicMessage(err, mapping, locale),
+		Code:   code,
+	}
+
+	if len(problem.Type) == 0 {
+		problem.Type = "about:blank"
+	}
+
+	if len(problem.Title) == 0 {
+		problem.Title = http.StatusText(mapping.HTTPStatus)
+	}
+
+	if mapping.ExposeMetadata {
+		problem.Metadata = metadataOf(err)
+	}
+
+	return problem
+}
+
+// WriteProblem writes error to the http.ResponseWriter as the RFC 7807 problem+json body.
+// The locale of the user-facing message is taken from the Accept-Language header of request.
+func (m *StatusMapper) WriteProblem(w http.ResponseWriter, r *http.Request, err error) error {
+	locale := ""
+	if r != nil {
+		locale = preferredLocale(r.Header.Get("Accept-Language"))
+	}
+
+	problem := m.ToLocalizedProblem(err, locale)
+	if r != nil && r.URL != nil {
+		problem.Instance = r.URL.Path
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	return json.NewEncoder(w).Encode(problem)
+}
+
+// ToGRPCStatus converts error into the gRPC status and details.
+// The internal message of error is never returned.
+func (m *StatusMapper) ToGRPCStatus(err error) GRPCStatus {
+	return m.ToLocalizedGRPCStatus(err, "")
+}
+
+// ToLocalizedGRPCStatus is like ToGRPCStatus but the message is the user-facing message of error in the locale
+// if error has been created from a Catalog.
+func (m *StatusMapper) ToLocalizedGRPCStatus(err error, locale string) GRPCStatus {
+	code := CodeOf(err)
+	mapping := m.Mapping(code)
+
+	info := ErrorInfo{
+		Reason: string(code),
+		Domain: m.domain,
+	}
+
+	if mapping.ExposeMetadata {
+		info.Metadata = metadataOf(err)
+	}
+
+	return GRPCStatus{
+		Code:    mapping.GRPCCode,
+		Message: publicMessage(err, mapping, locale),
+		Details: []ErrorInfo{info},
+	}
+}
+
+// withDefaults returns the mapping whose unset HTTP status and gRPC code are filled with the status of unknown error.
+func (m Mapping) withDefaults() Mapping {
+	if m.HTTPStatus == 0 {
+		m.HTTPStatus = http.StatusInternalServerError
+	}
+
+	if m.GRPCCode == GRPCOK {
+		m.GRPCCode = GRPCUnknown
+	}
+
+	return m
+}
+
+// CodeOf returns the code of the first *ErrorWrapper in the error chain that has been assigned a code.
+// It returns CodeUnknown if there is no code.
+func CodeOf(err error) Code {
+	var errWrapper *ErrorWrapper
+	for errors.As(err, &errWrapper) {
+		if len(errWrapper.code) > 0 {
+			return errWrapper.code
+		}
+
+		err = errWrapper.err
+	}
+
+	return CodeUnknown
+}
+
+func metadataOf(err error) map[string]string {
+	var errWrapper *ErrorWrapper
+	if !errors.As(err, &errWrapper) || len(errWrapper.metadata) == 0 {
+		return nil
+	}
+
+	return errWrapper.Metadata()
+}
+
+func publicMessage(err error, mapping Mapping, locale string) string {
+	if message := UserMessageOf(err, locale); len(message) > 0 {
+		return message
+	}
+
+	if len(mapping.Message) > 0 {
+		return mapping.Message
+	}
+
+	return http.StatusText(mapping.HTTPStatus)
+}
+
+// preferredLocale returns the first language tag of the Accept-Language header.
+func preferredLocale(acceptLanguage string) string {
+	tag := acceptLanguage
+	if i := strings.IndexByte(tag, ','); i >= 0 {
+		tag = tag[:i]
+	}
+
+	if i := strings.IndexByte(tag, ';'); i >= 0 {
+		tag = tag[:i]
+	}
+
+	tag = strings.TrimSpace(tag)
+	if tag == "*" {
+		return ""
+	}
+
+	return tag
+}
+
+var defaultMappings = map[Code]Mapping{
+	CodeUnknown:            {HTTPStatus: http.StatusInternalServerError, GRPCCode: GRPCUnknown},
+	CodeCanceled:           {HTTPStatus: 499, GRPCCode: GRPCCanceled, Title: "Client Closed Request", Message: "Client Closed Request"},
+	CodeInvalidArgument:    {HTTPStatus: http.StatusBadRequest, GRPCCode: GRPCInvalidArgument},
+	CodeDeadlineExceeded:   {HTTPStatus: http.StatusGatewayTimeout, GRPCCode: GRPCDeadlineExceeded},
+	CodeNotFound:           {HTTPStatus: http.StatusNotFound, GRPCCode: GRPCNotFound},
+	CodeAlreadyExists:      {HTTPStatus: http.StatusConflict, GRPCCode: GRPCAlreadyExists},
+	CodePermissionDenied:   {HTTPStatus: http.StatusForbidden, GRPCCode: GRPCPermissionDenied},
+	CodeResourceExhausted:  {HTTPStatus: http.StatusTooManyRequests, GRPCCode: GRPCResourceExhausted},
+	CodeFailedPrecondition: {HTTPStatus: http.StatusBadRequest, GRPCCode: GRPCFailedPrecondition},
+	CodeAborted:            {HTTPStatus: http.StatusConflict, GRPCCode: GRPCAborted},
+	CodeUnimplemented:      {HTTPStatus: http.StatusNotImplemented, GRPCCode: GRPCUnimplemented},
+	CodeInternal:           {HTTPStatus: http.StatusInternalServerError, GRPCCode: GRPCInternal},
+	CodeUnavailable:        {HTTPStatus: http.StatusServiceUnavailable, GRPCCode: GRPCUnavailable},
+	CodeUnauthenticated:    {HTTPStatus: http.StatusUnauthorized, GRPCCode: GRPCUnauthenticated},
+}
@@ -0,0 +1,275 @@
+package ero
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeOf_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	errNotFound := New("Account id=123 not found").WithCode(CodeNotFound)
+	tables := []struct {
+		err          error
+		expectedCode Code
+	}{
+		{
+			err:          errNotFound,
+			expectedCode: CodeNotFound,
+		},
+		{
+			err:          errNotFound.AddContext("Component A called").AddStackTrace("Component B called"),
+			expectedCode: CodeNotFound,
+		},
+		{
+			err:          Wrap(errNotFound),
+			expectedCode: CodeNotFound,
+		},
+		{
+			err:          errors.WithMessage(errNotFound, "Component C called"),
+			expectedCode: CodeNotFound,
+		},
+		{
+			err:          New("Failed to open file"),
+			expectedCode: CodeUnknown,
+		},
+		{
+			err:          errors.New("Failed to open file"),
+			expectedCode: CodeUnknown,
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		code := CodeOf(table.err)
+
+		// THEN
+		assert.Equal(table.expectedCode, code)
+	}
+}
+
+func TestWithMetadata_SimpleInput_NotChangeOriginError(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	err := New("Failed to open file").WithMetadata("file", "test.csv")
+
+	// WHEN
+	errA := err.WithMetadata("component", "A")
+
+	// THEN
+	assert.Equal(map[string]string{"file": "test.csv"}, err.Metadata())
+	assert.Equal(map[string]string{"file": "test.csv", "component": "A"}, errA.Metadata())
+	assert.Equal(map[string]string{"file": "test.csv", "component": "A"}, errA.AddContext("Component B called").Metadata())
+}
+
+func TestToProblem_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	mapper := NewStatusMapper("go-utils").
+		Register("ACCOUNT_LOCKED", Mapping{
+			HTTPStatus: http.StatusLocked,
+			GRPCCode:   GRPCFailedPrecondition,
+			Type:       "https://example.com/problems/account-locked",
+			Title:      "Account locked",
+			Message:    "Your account has been locked",
+		})
+	tables := []struct {
+		err             error
+		expectedProblem Problem
+	}{
+		{
+			err: New("Account id=123 not found in table account").WithCode(CodeNotFound),
+			expectedProblem: Problem{
+				Type:   "about:blank",
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "Not Found",
+				Code:   CodeNotFound,
+			},
+		},
+		{
+			err: New("Account id=123 locked by admin").WithCode("ACCOUNT_LOCKED"),
+			expectedProblem: Problem{
+				Type:   "https://example.com/problems/account-locked",
+				Title:  "Account locked",
+				Status: http.StatusLocked,
+				Detail: "Your account has been locked",
+				Code:   "ACCOUNT_LOCKED",
+			},
+		},
+		{
+			err: New("Order id=456 has been shipped").WithCode(CodeFailedPrecondition),
+			expectedProblem: Problem{
+				Type:   "about:blank",
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "Bad Request",
+				Code:   CodeFailedPrecondition,
+			},
+		},
+		{
+			err: errors.New("dial tcp 10.30.17.173:4000: connect: connection refused"),
+			expectedProblem: Problem{
+				Type:   "about:blank",
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "Internal Server Error",
+				Code:   CodeUnknown,
+			},
+		},
+		{
+			err: New("Unknown code").WithCode("NOT_REGISTERED"),
+			expectedProblem: Problem{
+				Type:   "about:blank",
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "Internal Server Error",
+				Code:   "NOT_REGISTERED",
+			},
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		problem := mapper.ToProblem(table.err)
+
+		// THEN
+		assert.Equal(table.expectedProblem, problem)
+	}
+}
+
+func TestToProblem_ExposeMetadata_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	mapper := NewStatusMapper("go-utils").
+		Register(CodeInvalidArgument, Mapping{HTTPStatus: http.StatusBadRequest, GRPCCode: GRPCInvalidArgument, ExposeMetadata: true})
+	err := New("Field username is empty").WithCode(CodeInvalidArgument).WithMetadata("field", "username")
+
+	// WHEN
+	problem := mapper.ToProblem(err)
+	problemNotFound := mapper.ToProblem(New("Not found").WithCode(CodeNotFound).WithMetadata("table", "account"))
+
+	// THEN
+	assert.Equal(map[string]string{"field": "username"}, problem.Metadata)
+	assert.Nil(problemNotFound.Metadata)
+}
+
+func TestWriteProblem_SimpleInput_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	mapper := NewStatusMapper("go-utils")
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/accounts/123", nil)
+	err := New("Account id=123 not found in table account").WithCode(CodeNotFound)
+
+	// WHEN
+	errWrite := mapper.WriteProblem(recorder, request, err)
+
+	// THEN
+	assert.Nil(errWrite)
+	assert.Equal(http.StatusNotFound, recorder.Code)
+	assert.Equal(ProblemContentType, recorder.Header().Get("Content-Type"))
+	assert.NotContains(recorder.Body.String(), "table account")
+
+	var problem Problem
+	assert.Nil(json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal("/accounts/123", problem.Instance)
+	assert.Equal(CodeNotFound, problem.Code)
+}
+
+func TestWriteProblem_MappingWithoutStatus_InternalServerError(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	mapper := NewStatusMapper("go-utils").
+		Register("ACCOUNT_LOCKED", Mapping{GRPCCode: GRPCInternal}).
+		SetFallback(Mapping{Message: "Something went wrong"})
+	recorder := httptest.NewRecorder()
+	recorderFallback := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/accounts/123", nil)
+
+	// WHEN
+	errWrite := mapper.WriteProblem(recorder, request, New("Account id=123 locked").WithCode("ACCOUNT_LOCKED"))
+	errWriteFallback := mapper.WriteProblem(recorderFallback, request, New("Account id=123 locked").WithCode("NOT_REGISTERED"))
+	status := mapper.ToGRPCStatus(New("Account id=123 locked").WithCode("NOT_REGISTERED"))
+
+	// THEN
+	assert.Nil(errWrite)
+	assert.Nil(errWriteFallback)
+	assert.Equal(http.StatusInternalServerError, recorder.Code)
+	assert.Equal(http.StatusInternalServerError, recorderFallback.Code)
+	assert.Contains(recorderFallback.Body.String(), "Something went wrong")
+	assert.Equal(GRPCInternal, mapper.Mapping("ACCOUNT_LOCKED").GRPCCode)
+	assert.Equal(GRPCUnknown, status.Code)
+}
+
+func TestToGRPCStatus_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+	mapper := NewStatusMapper("go-utils")
+	tables := []struct {
+		err             error
+		expectedCode    GRPCCode
+		expectedMessage string
+		expectedReason  string
+	}{
+		{
+			err:             New("Account id=123 not found").WithCode(CodeNotFound),
+			expectedCode:    GRPCNotFound,
+			expectedMessage: "Not Found",
+			expectedReason:  "NOT_FOUND",
+		},
+		{
+			err:             New("Token expired").WithCode(CodeUnauthenticated),
+			expectedCode:    GRPCUnauthenticated,
+			expectedMessage: "Unauthorized",
+			expectedReason:  "UNAUTHENTICATED",
+		},
+		{
+			err:             New("Failed to open file"),
+			expectedCode:    GRPCUnknown,
+			expectedMessage: "Internal Server Error",
+			expectedReason:  "UNKNOWN",
+		},
+	}
+
+	for _, table := range tables {
+		// WHEN
+		status := mapper.ToGRPCStatus(table.err)
+
+		// THEN
+		assert.Equal(table.expectedCode, status.Code)
+		assert.Equal(table.expectedMessage, status.Message)
+		assert.Len(status.Details, 1)
+		assert.Equal(table.expectedReason, status.Details[0].Reason)
+		assert.Equal("go-utils", status.Details[0].Domain)
+	}
+}
+
+func TestGRPCCodeString_MultipleCase_Success(t *testing.T) {
+	// GIVEN
+	assert := assert.New(t)
+
+	// WHEN
+	// THEN
+	assert.Equal("NotFound", GRPCNotFound.String())
+	assert.Equal("Unauthenticated", GRPCUnauthenticated.String())
+	assert.Equal("Code(99)", GRPCCode(99).String())
+}
+
+func BenchmarkToProblem(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	mapper := NewStatusMapper("go-utils")
+	err := New("Benchmark create error").WithCode(CodeNotFound).AddContext("Component A called")
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mapper.ToProblem(err)
+		}
+	})
+}